@@ -0,0 +1,483 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/devoteamgcloud/terraform-provider-looker/internal/provider"
+	"github.com/devoteamgcloud/terraform-provider-looker/pkg/lookergo"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// exportFunc enumerates the instance and appends a resource block per object of one resource type.
+type exportFunc func(ctx context.Context, e *exporter) error
+
+// resourceExporters lists the supported resource types. Types are exported in this order, so resources only reference
+// resource types listed before them.
+var resourceExporters = []struct {
+	resourceType string
+	export       exportFunc
+}{
+	{"looker_permission_set", exportPermissionSets},
+	{"looker_model_set", exportModelSets},
+	{"looker_role", exportRoles},
+	{"looker_group", exportGroups},
+	{"looker_user", exportUsers},
+	{"looker_group_member", exportGroupMembers},
+	{"looker_role_groups", exportRoleGroups},
+	{"looker_user_roles", exportUserRoles},
+	{"looker_user_attribute", exportUserAttributes},
+	{"looker_folder", exportFolders},
+}
+
+// unsupportedResourceTypes returns the resource types of the provider that have no exporter, sorted.
+func unsupportedResourceTypes() []string {
+	supported := map[string]bool{}
+	for _, re := range resourceExporters {
+		supported[re.resourceType] = true
+	}
+	var unsupported []string
+	for resourceType := range provider.New("looker-export")().ResourcesMap {
+		if !supported[resourceType] {
+			unsupported = append(unsupported, resourceType)
+		}
+	}
+	sort.Strings(unsupported)
+	return unsupported
+}
+
+// allGroupId is the id of the built-in "All Users" group, which can not be managed.
+const allGroupId = 1
+
+type exporter struct {
+	client  *lookergo.Client
+	files   map[string]*hclwrite.File
+	imports *hclwrite.File
+	labels  map[string]map[string]string // Resource type -> Looker id -> resource label
+}
+
+func newExporter(client *lookergo.Client) *exporter {
+	return &exporter{
+		client:  client,
+		files:   map[string]*hclwrite.File{},
+		imports: hclwrite.NewEmptyFile(),
+		labels:  map[string]map[string]string{},
+	}
+}
+
+// run exports the given resource types, or all supported resource types when none are given.
+func (e *exporter) run(ctx context.Context, resourceTypes []string) error {
+	supported := map[string]bool{}
+	for _, re := range resourceExporters {
+		supported[re.resourceType] = true
+	}
+	wanted := map[string]bool{}
+	for _, resourceType := range resourceTypes {
+		if !supported[resourceType] {
+			return fmt.Errorf("unsupported resource type %q", resourceType)
+		}
+		wanted[resourceType] = true
+	}
+
+	for _, re := range resourceExporters {
+		if len(wanted) > 0 && !wanted[re.resourceType] {
+			continue
+		}
+		if err := re.export(ctx, e); err != nil {
+			return fmt.Errorf("exporting %s: %w", re.resourceType, err)
+		}
+	}
+	return nil
+}
+
+var labelInvalidChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// label assigns a unique resource label to the object with the given id, derived from its name.
+func (e *exporter) label(resourceType, id, name string) string {
+	if e.labels[resourceType] == nil {
+		e.labels[resourceType] = map[string]string{}
+	}
+	if label, ok := e.labels[resourceType][id]; ok {
+		return label
+	}
+
+	label := strings.Trim(labelInvalidChars.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if label == "" || (label[0] >= '0' && label[0] <= '9') {
+		label = strings.TrimPrefix(resourceType, "looker_") + "_" + label
+	}
+	for _, used := range e.labels[resourceType] {
+		if used == label {
+			label = fmt.Sprintf("%s_%s", label, labelInvalidChars.ReplaceAllString(id, "_"))
+			break
+		}
+	}
+	e.labels[resourceType][id] = label
+	return label
+}
+
+// resource appends a resource block and the matching import block, and returns the body of the resource block.
+func (e *exporter) resource(resourceType, id, name string) *hclwrite.Body {
+	label := e.label(resourceType, id, name)
+
+	file, ok := e.files[resourceType]
+	if !ok {
+		file = hclwrite.NewEmptyFile()
+		e.files[resourceType] = file
+	} else {
+		file.Body().AppendNewline()
+	}
+	block := file.Body().AppendNewBlock("resource", []string{resourceType, label})
+
+	if len(e.imports.Body().Blocks()) > 0 {
+		e.imports.Body().AppendNewline()
+	}
+	importBody := e.imports.Body().AppendNewBlock("import", nil).Body()
+	importBody.SetAttributeTraversal("to", hcl.Traversal{hcl.TraverseRoot{Name: resourceType}, hcl.TraverseAttr{Name: label}})
+	importBody.SetAttributeValue("id", cty.StringVal(id))
+
+	return block.Body()
+}
+
+// ref returns a reference to the id of an exported resource, or the literal id if the object was not exported.
+func (e *exporter) ref(resourceType, id string) hclwrite.Tokens {
+	if label, ok := e.labels[resourceType][id]; ok {
+		return hclwrite.TokensForTraversal(hcl.Traversal{
+			hcl.TraverseRoot{Name: resourceType},
+			hcl.TraverseAttr{Name: label},
+			hcl.TraverseAttr{Name: "id"},
+		})
+	}
+	return hclwrite.TokensForValue(cty.StringVal(id))
+}
+
+// refs returns a list of references, see ref.
+func (e *exporter) refs(resourceType string, ids []string) hclwrite.Tokens {
+	sort.Strings(ids)
+	elems := make([]hclwrite.Tokens, len(ids))
+	for i, id := range ids {
+		elems[i] = e.ref(resourceType, id)
+	}
+	return hclwrite.TokensForTuple(elems)
+}
+
+func stringList(values []string) cty.Value {
+	if len(values) == 0 {
+		return cty.ListValEmpty(cty.String)
+	}
+	sorted := append([]string(nil), values...)
+	sort.Strings(sorted)
+	list := make([]cty.Value, len(sorted))
+	for i, v := range sorted {
+		list[i] = cty.StringVal(v)
+	}
+	return cty.ListVal(list)
+}
+
+// write writes a file per exported resource type and an imports.tf file to dir. Existing files are only replaced when
+// overwrite is true.
+func (e *exporter) write(dir string, overwrite bool) ([]string, error) {
+	files := map[string]*hclwrite.File{"imports.tf": e.imports}
+	for resourceType, file := range e.files {
+		files[resourceType+".tf"] = file
+	}
+
+	flag := os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	if !overwrite {
+		flag |= os.O_EXCL
+	}
+	var written []string
+	for name, file := range files {
+		path := filepath.Join(dir, name)
+		f, err := os.OpenFile(path, flag, 0o644)
+		if err != nil {
+			return written, err
+		}
+		_, err = f.Write(hclwrite.Format(file.Bytes()))
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return written, err
+		}
+		written = append(written, path)
+	}
+	sort.Strings(written)
+	return written, nil
+}
+
+func exportPermissionSets(ctx context.Context, e *exporter) error {
+	permissionSets, _, err := e.client.PermissionSets.List(ctx, nil)
+	if err != nil {
+		return err
+	}
+	for _, permissionSet := range permissionSets {
+		if permissionSet.BuiltIn {
+			continue
+		}
+		body := e.resource("looker_permission_set", permissionSet.Id, permissionSet.Name)
+		body.SetAttributeValue("name", cty.StringVal(permissionSet.Name))
+		body.SetAttributeValue("permissions", stringList(permissionSet.Permissions))
+	}
+	return nil
+}
+
+func exportModelSets(ctx context.Context, e *exporter) error {
+	modelSets, _, err := e.client.ModelSets.List(ctx)
+	if err != nil {
+		return err
+	}
+	for _, modelSet := range modelSets {
+		if modelSet.BuiltIn {
+			continue
+		}
+		body := e.resource("looker_model_set", modelSet.Id, modelSet.Name)
+		body.SetAttributeValue("name", cty.StringVal(modelSet.Name))
+		body.SetAttributeValue("models", stringList(modelSet.Models))
+	}
+	return nil
+}
+
+func exportRoles(ctx context.Context, e *exporter) error {
+	roles, _, err := e.client.Roles.List(ctx, nil)
+	if err != nil {
+		return err
+	}
+	for _, role := range roles {
+		id := fmt.Sprint(role.Id)
+		body := e.resource("looker_role", id, role.Name)
+		body.SetAttributeValue("name", cty.StringVal(role.Name))
+		body.SetAttributeRaw("permission_set_id", e.ref("looker_permission_set", role.PermissionSet.Id))
+		body.SetAttributeRaw("model_set_id", e.ref("looker_model_set", role.ModelSet.Id))
+	}
+	return nil
+}
+
+func exportGroups(ctx context.Context, e *exporter) error {
+	groups, _, err := e.client.Groups.List(ctx, nil)
+	if err != nil {
+		return err
+	}
+	for _, group := range groups {
+		if group.Id == allGroupId {
+			continue
+		}
+		body := e.resource("looker_group", fmt.Sprint(group.Id), group.Name)
+		body.SetAttributeValue("name", cty.StringVal(group.Name))
+	}
+	return nil
+}
+
+// userEmail returns the email of the login of user, or its contact email.
+func userEmail(user lookergo.User) string {
+	if user.CredentialsEmail != nil && user.CredentialsEmail.Email != "" {
+		return user.CredentialsEmail.Email
+	}
+	return user.Email
+}
+
+// userName returns the name the labels of the resources of user are derived from: its email, or its full name.
+func userName(user lookergo.User) string {
+	if email := userEmail(user); email != "" {
+		return email
+	}
+	return strings.TrimSpace(user.FirstName + " " + user.LastName)
+}
+
+func exportUsers(ctx context.Context, e *exporter) error {
+	users, _, err := e.client.Users.List(ctx, nil)
+	if err != nil {
+		return err
+	}
+	for _, user := range users {
+		if user.CredentialsEmbed != nil && len(*user.CredentialsEmbed) > 0 {
+			// Embed users are created by Looker on embed logins.
+			continue
+		}
+		email := userEmail(user)
+		body := e.resource("looker_user", user.Id, userName(user))
+		if user.FirstName != "" {
+			body.SetAttributeValue("first_name", cty.StringVal(user.FirstName))
+		}
+		if user.LastName != "" {
+			body.SetAttributeValue("last_name", cty.StringVal(user.LastName))
+		}
+		if email != "" {
+			body.SetAttributeValue("email", cty.StringVal(email))
+		}
+		// The roles of the user are exported as looker_user_roles.
+		body.AppendNewline()
+		body.AppendNewBlock("lifecycle", nil).Body().SetAttributeRaw("ignore_changes", hclwrite.TokensForTuple(
+			[]hclwrite.Tokens{hclwrite.TokensForTraversal(hcl.Traversal{hcl.TraverseRoot{Name: "roles"}})},
+		))
+	}
+	return nil
+}
+
+// memberBlocks appends a block of the given type per id, referencing the exported resource of resourceType.
+func (e *exporter) memberBlocks(body *hclwrite.Body, blockType, resourceType string, ids []string) {
+	sort.Strings(ids)
+	for _, id := range ids {
+		body.AppendNewBlock(blockType, nil).Body().SetAttributeRaw("id", e.ref(resourceType, id))
+	}
+}
+
+func exportGroupMembers(ctx context.Context, e *exporter) error {
+	groups, _, err := e.client.Groups.List(ctx, nil)
+	if err != nil {
+		return err
+	}
+	for _, group := range groups {
+		if group.Id == allGroupId {
+			continue
+		}
+		users, _, err := e.client.Groups.ListMemberUsers(ctx, group.Id, nil)
+		if err != nil {
+			return err
+		}
+		groupMembers, _, err := e.client.Groups.ListMemberGroups(ctx, group.Id, nil)
+		if err != nil {
+			return err
+		}
+		if len(users) == 0 && len(groupMembers) == 0 {
+			continue
+		}
+
+		id := fmt.Sprint(group.Id)
+		body := e.resource("looker_group_member", id, group.Name)
+		body.SetAttributeRaw("target_group_id", e.ref("looker_group", id))
+		userIds := make([]string, len(users))
+		for i, user := range users {
+			userIds[i] = user.Id
+		}
+		e.memberBlocks(body, "user", "looker_user", userIds)
+		groupIds := make([]string, len(groupMembers))
+		for i, member := range groupMembers {
+			groupIds[i] = fmt.Sprint(member.Id)
+		}
+		e.memberBlocks(body, "group", "looker_group", groupIds)
+	}
+	return nil
+}
+
+func exportRoleGroups(ctx context.Context, e *exporter) error {
+	roles, _, err := e.client.Roles.List(ctx, nil)
+	if err != nil {
+		return err
+	}
+	for _, role := range roles {
+		groups, _, err := e.client.Roles.RoleGroupsList(ctx, role.Id, nil)
+		if err != nil {
+			return err
+		}
+		if len(groups) == 0 {
+			continue
+		}
+
+		id := fmt.Sprint(role.Id)
+		body := e.resource("looker_role_groups", id, role.Name)
+		body.SetAttributeRaw("role_id", e.ref("looker_role", id))
+		groupIds := make([]string, len(groups))
+		for i, group := range groups {
+			groupIds[i] = fmt.Sprint(group.Id)
+		}
+		e.memberBlocks(body, "group", "looker_group", groupIds)
+	}
+	return nil
+}
+
+func exportUserRoles(ctx context.Context, e *exporter) error {
+	users, _, err := e.client.Users.List(ctx, nil)
+	if err != nil {
+		return err
+	}
+	for _, user := range users {
+		if user.CredentialsEmbed != nil && len(*user.CredentialsEmbed) > 0 {
+			continue
+		}
+		// Only the roles assigned directly: the ones of the groups of the user are exported as looker_role_groups.
+		roles, _, err := e.client.Users.GetDirectRoles(ctx, user.Id)
+		if err != nil {
+			return err
+		}
+		if len(roles) == 0 {
+			continue
+		}
+
+		roleIds := make([]string, len(roles))
+		for i, role := range roles {
+			roleIds[i] = fmt.Sprint(role.Id)
+		}
+		body := e.resource("looker_user_roles", user.Id, userName(user))
+		body.SetAttributeRaw("user_id", e.ref("looker_user", user.Id))
+		body.SetAttributeRaw("role_ids", e.refs("looker_role", roleIds))
+	}
+	return nil
+}
+
+func exportUserAttributes(ctx context.Context, e *exporter) error {
+	userAttributes, _, err := e.client.UserAttributes.List(ctx, nil)
+	if err != nil {
+		return err
+	}
+	for _, userAttribute := range userAttributes {
+		if userAttribute.IsSystem != nil && *userAttribute.IsSystem {
+			continue
+		}
+		body := e.resource("looker_user_attribute", userAttribute.Id, userAttribute.Name)
+		body.SetAttributeValue("name", cty.StringVal(userAttribute.Name))
+		body.SetAttributeValue("label", cty.StringVal(userAttribute.Label))
+		body.SetAttributeValue("type", cty.StringVal(userAttribute.Type))
+		if userAttribute.DefaultValue != "" {
+			body.SetAttributeValue("default_value", cty.StringVal(userAttribute.DefaultValue))
+		}
+		if userAttribute.ValueIsHidden != nil {
+			body.SetAttributeValue("value_is_hidden", cty.BoolVal(*userAttribute.ValueIsHidden))
+		}
+		if userAttribute.UserCanView != nil {
+			body.SetAttributeValue("user_can_view", cty.BoolVal(*userAttribute.UserCanView))
+		}
+		if userAttribute.UserCanEdit != nil {
+			body.SetAttributeValue("user_can_edit", cty.BoolVal(*userAttribute.UserCanEdit))
+		}
+		if userAttribute.HiddenValueDomainWhitelist != nil && *userAttribute.HiddenValueDomainWhitelist != "" {
+			body.SetAttributeValue("hidden_value_domain_whitelist", cty.StringVal(*userAttribute.HiddenValueDomainWhitelist))
+		}
+	}
+	return nil
+}
+
+func exportFolders(ctx context.Context, e *exporter) error {
+	folders, _, err := e.client.Folders.List(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	var managed []lookergo.Folder
+	for _, folder := range folders {
+		// Root, personal and embed folders are created by Looker.
+		if folder.IsSharedRoot || folder.IsUsersRoot || folder.IsEmbedSharedRoot || folder.IsEmbedUsersRoot ||
+			folder.IsPersonal || folder.IsPersonalDescendant || folder.IsEmbed {
+			continue
+		}
+		managed = append(managed, folder)
+	}
+	// Assign all labels first, so folders can reference parents listed after them.
+	for _, folder := range managed {
+		e.label("looker_folder", folder.Id, folder.Name)
+	}
+	for _, folder := range managed {
+		body := e.resource("looker_folder", folder.Id, folder.Name)
+		body.SetAttributeValue("name", cty.StringVal(folder.Name))
+		if folder.ParentId != "" {
+			body.SetAttributeRaw("parent_id", e.ref("looker_folder", folder.ParentId))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,207 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/devoteamgcloud/terraform-provider-looker/pkg/lookergo"
+)
+
+func setupInstance(t *testing.T) *lookergo.Client {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	responses := map[string]string{
+		"/api/4.0/permission_sets": `[{"id":"1","name":"Admin","built_in":true,"permissions":["administer"]},
+			{"id":"7","name":"Viewer","permissions":["see_looks","access_data"]}]`,
+		"/api/4.0/model_sets": `[{"id":"1","name":"All","built_in":true},{"id":"4","name":"Sales","models":["thelook"]}]`,
+		"/api/4.0/roles": `[{"id":"9","name":"Sales viewer","permission_set":{"id":"7"},"model_set":{"id":"4"}},
+			{"id":"2","name":"Admin","permission_set":{"id":"1"},"model_set":{"id":"1"}}]`,
+		"/api/4.0/groups":          `[{"id":"1","name":"All Users"},{"id":"5","name":"Sales team"},{"id":"6","name":"EMEA"}]`,
+		"/api/4.0/groups/5/users":  `[{"id":"60"}]`,
+		"/api/4.0/groups/5/groups": `[{"id":"6"}]`,
+		"/api/4.0/groups/6/users":  `[]`,
+		"/api/4.0/groups/6/groups": `[]`,
+		"/api/4.0/roles/9/groups":  `[{"id":"5"},{"id":"1"}]`,
+		"/api/4.0/roles/2/groups":  `[]`,
+		"/api/4.0/users/60/roles":  `[{"id":"2"}]`,
+		"/api/4.0/users": `[{"id":"60","first_name":"Jane","last_name":"Doe","credentials_email":{"email":"jane@example.com"},"role_ids":["9","2"]},
+			{"id":"61","credentials_embed":[{"external_user_id":"x"}]}]`,
+		"/api/4.0/user_attributes": `[{"id":"1","name":"email","label":"Email","type":"string","is_system":true},
+			{"id":"12","name":"region","label":"Region","type":"string","default_value":"EU","value_is_hidden":false,"user_can_view":true,"user_can_edit":false}]`,
+		"/api/4.0/folders": `[{"id":"1","name":"Shared","is_shared_root":true},{"id":"40","name":"Sales","parent_id":"1"},
+			{"id":"41","name":"Reports","parent_id":"40"},{"id":"42","name":"Jane","is_personal":true}]`,
+	}
+	for path, body := range responses {
+		body := body
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, body)
+		})
+	}
+
+	client := lookergo.NewClient(nil)
+	if err := client.SetBaseURL(server.URL + "/api/"); err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func TestExporter(t *testing.T) {
+	e := newExporter(setupInstance(t))
+	if err := e.run(context.Background(), nil); err != nil {
+		t.Fatalf("run returned error: %v", err)
+	}
+
+	dir := t.TempDir()
+	if _, err := e.write(dir, false); err != nil {
+		t.Fatalf("write returned error: %v", err)
+	}
+
+	expected := map[string]string{
+		"looker_permission_set.tf": `resource "looker_permission_set" "viewer" {
+  name        = "Viewer"
+  permissions = ["access_data", "see_looks"]
+}
+`,
+		"looker_model_set.tf": `resource "looker_model_set" "sales" {
+  name   = "Sales"
+  models = ["thelook"]
+}
+`,
+		"looker_role.tf": `resource "looker_role" "sales_viewer" {
+  name              = "Sales viewer"
+  permission_set_id = looker_permission_set.viewer.id
+  model_set_id      = looker_model_set.sales.id
+}
+
+resource "looker_role" "admin" {
+  name              = "Admin"
+  permission_set_id = "1"
+  model_set_id      = "1"
+}
+`,
+		"looker_group.tf": `resource "looker_group" "sales_team" {
+  name = "Sales team"
+}
+
+resource "looker_group" "emea" {
+  name = "EMEA"
+}
+`,
+		"looker_group_member.tf": `resource "looker_group_member" "sales_team" {
+  target_group_id = looker_group.sales_team.id
+  user {
+    id = looker_user.jane_example_com.id
+  }
+  group {
+    id = looker_group.emea.id
+  }
+}
+`,
+		"looker_role_groups.tf": `resource "looker_role_groups" "sales_viewer" {
+  role_id = looker_role.sales_viewer.id
+  group {
+    id = "1"
+  }
+  group {
+    id = looker_group.sales_team.id
+  }
+}
+`,
+		"looker_user_roles.tf": `resource "looker_user_roles" "jane_example_com" {
+  user_id  = looker_user.jane_example_com.id
+  role_ids = [looker_role.admin.id]
+}
+`,
+		"looker_user.tf": `resource "looker_user" "jane_example_com" {
+  first_name = "Jane"
+  last_name  = "Doe"
+  email      = "jane@example.com"
+
+  lifecycle {
+    ignore_changes = [roles]
+  }
+}
+`,
+		"looker_user_attribute.tf": `resource "looker_user_attribute" "region" {
+  name            = "region"
+  label           = "Region"
+  type            = "string"
+  default_value   = "EU"
+  value_is_hidden = false
+  user_can_view   = true
+  user_can_edit   = false
+}
+`,
+		"looker_folder.tf": `resource "looker_folder" "sales" {
+  name      = "Sales"
+  parent_id = "1"
+}
+
+resource "looker_folder" "reports" {
+  name      = "Reports"
+  parent_id = looker_folder.sales.id
+}
+`,
+	}
+	for name, want := range expected {
+		got, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Errorf("reading %s: %v", name, err)
+			continue
+		}
+		if string(got) != want {
+			t.Errorf("%s =\n%s\nexpected\n%s", name, got, want)
+		}
+	}
+
+	imports, err := os.ReadFile(filepath.Join(dir, "imports.tf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantImport := `import {
+  to = looker_folder.reports
+  id = "41"
+}
+`
+	if !strings.Contains(string(imports), wantImport) {
+		t.Errorf("imports.tf does not contain\n%s\ngot\n%s", wantImport, imports)
+	}
+
+	if _, err = e.write(dir, false); err == nil {
+		t.Error("write expected an error when files already exist")
+	}
+	if _, err = e.write(dir, true); err != nil {
+		t.Errorf("write with overwrite returned error: %v", err)
+	}
+}
+
+func TestExporter_UnsupportedType(t *testing.T) {
+	e := newExporter(lookergo.NewClient(nil))
+	if err := e.run(context.Background(), []string{"looker_unknown"}); err == nil {
+		t.Error("run expected an error for an unsupported resource type")
+	}
+}
+
+func TestUnsupportedResourceTypes(t *testing.T) {
+	unsupported := unsupportedResourceTypes()
+	has := map[string]bool{}
+	for _, resourceType := range unsupported {
+		has[resourceType] = true
+	}
+	if !has["looker_connection"] || !has["looker_alert"] {
+		t.Errorf("unsupported = %v, expected looker_connection and looker_alert", unsupported)
+	}
+	for _, re := range resourceExporters {
+		if has[re.resourceType] {
+			t.Errorf("unsupported = %v, should not list the exported %s", unsupported, re.resourceType)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+// Command looker-export enumerates an existing Looker instance and writes Terraform configuration for it, together
+// with import blocks, so the instance can be brought under management of this provider in one step.
+//
+//	looker-export -base-url https://example.looker.com -out ./looker
+//	terraform -chdir=./looker plan
+//
+// Credentials are read from the same environment variables as the provider: LOOKER_BASE_URL, LOOKER_API_CLIENT_ID and
+// LOOKER_API_CLIENT_SECRET. The generated import blocks require Terraform 1.5 or later.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/devoteamgcloud/terraform-provider-looker/pkg/lookergo"
+)
+
+func main() {
+	if err := run(context.Background(), os.Args[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, "looker-export:", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("looker-export", flag.ContinueOnError)
+	baseURL := fs.String("base-url", os.Getenv("LOOKER_BASE_URL"), "URL of the Looker instance, e.g. https://example.looker.com")
+	clientId := fs.String("client-id", os.Getenv("LOOKER_API_CLIENT_ID"), "API client id")
+	clientSecret := fs.String("client-secret", os.Getenv("LOOKER_API_CLIENT_SECRET"), "API client secret")
+	out := fs.String("out", ".", "directory the .tf files are written to")
+	types := fs.String("types", "", "comma separated resource types to export, e.g. looker_group,looker_role (default all)")
+	overwrite := fs.Bool("overwrite", false, "replace existing files in the output directory")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: looker-export [flags]\n\nSupported resource types:\n")
+		for _, re := range resourceExporters {
+			fmt.Fprintf(fs.Output(), "  %s\n", re.resourceType)
+		}
+		fmt.Fprintf(fs.Output(), "\nNot supported, to be written by hand:\n  %s\n", strings.Join(unsupportedResourceTypes(), "\n  "))
+		fmt.Fprintf(fs.Output(), "\nFlags:\n")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *baseURL == "" || *clientId == "" || *clientSecret == "" {
+		return fmt.Errorf("base-url, client-id and client-secret are required")
+	}
+
+	client, err := newClient(ctx, *baseURL, *clientId, *clientSecret)
+	if err != nil {
+		return err
+	}
+
+	var resourceTypes []string
+	if *types != "" {
+		for _, resourceType := range strings.Split(*types, ",") {
+			resourceTypes = append(resourceTypes, strings.TrimSpace(resourceType))
+		}
+	}
+
+	e := newExporter(client)
+	if err = e.run(ctx, resourceTypes); err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(*out, 0o755); err != nil {
+		return err
+	}
+	written, err := e.write(*out, *overwrite)
+	for _, path := range written {
+		fmt.Println("Wrote", path)
+	}
+	if err == nil && len(resourceTypes) == 0 {
+		// The generated configuration is not the whole instance: say what is missing.
+		fmt.Fprintf(os.Stderr, "Not exported, to be written by hand: %s\n", strings.Join(unsupportedResourceTypes(), ", "))
+	}
+	return err
+}
+
+// newClient returns a client for the instance at baseURL. Like the provider, /api/ is appended to the URL when missing.
+func newClient(ctx context.Context, baseURL, clientId, clientSecret string) (*lookergo.Client, error) {
+	apiURL := strings.TrimSuffix(baseURL, "/")
+	if !strings.HasSuffix(apiURL, "/api") {
+		apiURL += "/api"
+	}
+
+	client := lookergo.NewClient(nil)
+	if err := client.SetBaseURL(apiURL + "/"); err != nil {
+		return nil, err
+	}
+	if err := client.SetOauthCredentials(ctx, clientId, clientSecret); err != nil {
+		return nil, err
+	}
+	if err := client.SetUserAgent("looker-export"); err != nil {
+		return nil, err
+	}
+	return client, nil
+}
@@ -0,0 +1,3 @@
+data "looker_datagroups" "sales" {
+  model_name = "sales"
+}
\ No newline at end of file
@@ -0,0 +1,3 @@
+data "looker_folders" "shared" {
+  parent_id = "1"
+}
\ No newline at end of file
@@ -0,0 +1,4 @@
+data "looker_groups" "sales" {
+  name      = "Sales%"
+  parent_id = "1"
+}
\ No newline at end of file
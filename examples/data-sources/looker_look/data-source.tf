@@ -0,0 +1,3 @@
+data "looker_look" "revenue_per_day" {
+  title = "Revenue per day"
+}
\ No newline at end of file
@@ -0,0 +1,3 @@
+data "looker_lookml_model" "sales" {
+  name = "sales"
+}
\ No newline at end of file
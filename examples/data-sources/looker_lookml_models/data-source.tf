@@ -0,0 +1,9 @@
+data "looker_lookml_models" "hub" {
+  project_name  = "hub"
+  exclude_empty = true
+}
+
+resource "looker_model_set" "hub" {
+  name   = "hub"
+  models = data.looker_lookml_models.hub.names
+}
\ No newline at end of file
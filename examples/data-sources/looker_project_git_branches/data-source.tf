@@ -0,0 +1,3 @@
+data "looker_project_git_branches" "hub" {
+  project_name = "hub"
+}
\ No newline at end of file
@@ -0,0 +1,3 @@
+data "looker_roles" "custom" {
+  built_in = false
+}
\ No newline at end of file
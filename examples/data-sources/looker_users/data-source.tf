@@ -0,0 +1,11 @@
+data "looker_users" "example" {
+  email_domain = "example.com"
+  is_disabled  = false
+}
+
+# Add every active example.com user to a group.
+resource "looker_group_user" "example" {
+  for_each = toset(data.looker_users.example.ids)
+  group_id = "4"
+  user_id  = each.value
+}
\ No newline at end of file
@@ -12,3 +12,11 @@ provider "looker" {
   client_id     = "12345678"                                # Optionally use env var LOOKER_API_CLIENT_ID
   client_secret = "abcd1234"                                # Optionally use env var LOOKER_API_CLIENT_SECRET
 }
+
+# Reuse the looker.ini file of the Looker SDKs, with a section per environment.
+provider "looker" {
+  alias          = "staging"
+  config_path    = pathexpand("~/looker.ini") # Optionally use env var LOOKER_CONFIG_PATH
+  config_section = "Staging"                  # Optionally use env var LOOKER_CONFIG_SECTION
+  ca_cert_file   = "/etc/ssl/certs/internal-ca.pem"
+}
@@ -0,0 +1 @@
+terraform import looker_alert.default {{alert_id}}
\ No newline at end of file
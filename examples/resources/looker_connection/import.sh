@@ -0,0 +1 @@
+terraform import looker_connection.default {{connection_name}}
\ No newline at end of file
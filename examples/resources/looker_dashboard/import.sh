@@ -0,0 +1 @@
+terraform import looker_dashboard.default {{dashboard_id}}
\ No newline at end of file
@@ -0,0 +1,36 @@
+resource "looker_folder" "sales" {
+  name = "Sales"
+}
+
+resource "looker_dashboard" "sales_overview" {
+  title            = "Sales overview"
+  folder_id        = looker_folder.sales.id
+  description      = "Daily sales figures"
+  refresh_interval = "1 hour"
+
+  filter {
+    name      = "date"
+    title     = "Date"
+    type      = "field_filter"
+    model     = "thelook"
+    explore   = "order_items"
+    dimension = "order_items.created_date"
+  }
+
+  element {
+    type       = "text"
+    title_text = "Sales overview"
+    body_text  = "Figures are refreshed every hour."
+  }
+
+  element {
+    look_id = "42"
+    title   = "Revenue per day"
+  }
+}
+
+# A dashboard described by its LookML serialization, e.g. exported from another instance.
+resource "looker_dashboard" "from_lookml" {
+  folder_id = looker_folder.sales.id
+  lookml    = file("${path.module}/dashboards/sales_overview.dashboard.lookml")
+}
@@ -0,0 +1,12 @@
+variable "etl_load_id" {
+  type = string
+}
+
+# Rebuild the PDTs of the etl datagroup after every ETL load.
+resource "looker_datagroup_trigger" "etl" {
+  model_name = "sales"
+  name       = "etl"
+  triggers = {
+    load_id = var.etl_load_id
+  }
+}
\ No newline at end of file
@@ -0,0 +1 @@
+terraform import looker_folder_access.default {{folder_id}}
\ No newline at end of file
@@ -0,0 +1,23 @@
+resource "looker_folder" "sales" {
+  name = "Sales"
+}
+
+resource "looker_folder_access" "sales" {
+  folder_id = looker_folder.sales.id
+  inherits  = false
+
+  grant {
+    group_id   = looker_group.sales_analysts.id
+    permission = "edit"
+  }
+
+  grant {
+    group_id   = looker_group.sales.id
+    permission = "view"
+  }
+
+  grant {
+    user_id    = looker_user.controller.id
+    permission = "view"
+  }
+}
@@ -0,0 +1 @@
+terraform import looker_group_group.default {{group_id}}:{{member_group_id}}
\ No newline at end of file
@@ -0,0 +1,4 @@
+resource "looker_group_group" "lights_staff" {
+  group_id        = "4"
+  member_group_id = "3"
+}
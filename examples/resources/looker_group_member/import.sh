@@ -0,0 +1 @@
+terraform import looker_group_member.default {{group_id}}
\ No newline at end of file
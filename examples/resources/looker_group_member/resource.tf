@@ -24,4 +24,14 @@ resource "looker_group_member" "member_binding_secundo" {
   group {
     id = "3"
   }
+}
+
+// All members of the group that are not declared here are removed.
+resource "looker_group_member" "admins" {
+  target_group_id = "5"
+  mode            = "authoritative"
+
+  user {
+    id = "1"
+  }
 }
\ No newline at end of file
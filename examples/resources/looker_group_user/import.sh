@@ -0,0 +1 @@
+terraform import looker_group_user.default {{group_id}}:{{user_id}}
\ No newline at end of file
@@ -0,0 +1,4 @@
+resource "looker_group_user" "kermit" {
+  group_id = "4"
+  user_id  = "1"
+}
@@ -0,0 +1 @@
+terraform import looker_ldap_config.main ldap_config
\ No newline at end of file
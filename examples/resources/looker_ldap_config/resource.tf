@@ -0,0 +1,30 @@
+resource "looker_ldap_config" "main" {
+  connection_host = "ldap.example.com"
+  connection_port = "636"
+  connection_tls  = true
+  auth_username   = "cn=looker,ou=services,dc=example,dc=com"
+  auth_password   = var.ldap_password
+
+  user_bind_base_dn             = "ou=users,dc=example,dc=com"
+  user_id_attribute_names       = "uid"
+  user_objectclass              = "person"
+  user_attribute_map_email      = "mail"
+  user_attribute_map_first_name = "givenName"
+  user_attribute_map_last_name  = "sn"
+  user_attribute_map_ldap_id    = "uid"
+
+  groups_base_dn          = "ou=groups,dc=example,dc=com"
+  groups_member_attribute = "member"
+  groups_user_attribute   = "dn"
+  set_roles_from_groups   = true
+
+  group_mapping {
+    name     = "looker-admins"
+    role_ids = [looker_role.admin.id]
+  }
+
+  # Test the connection, the service account and a user before applying changes.
+  test_config        = true
+  test_ldap_user     = "jane"
+  test_ldap_password = var.ldap_test_password
+}
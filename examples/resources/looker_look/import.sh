@@ -0,0 +1 @@
+terraform import looker_look.default {{look_id}}
\ No newline at end of file
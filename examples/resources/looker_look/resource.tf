@@ -0,0 +1,19 @@
+resource "looker_folder" "sales" {
+  name = "Sales"
+}
+
+resource "looker_look" "revenue_per_day" {
+  title     = "Revenue per day"
+  folder_id = looker_folder.sales.id
+
+  query {
+    model  = "thelook"
+    view   = "order_items"
+    fields = ["order_items.created_date", "order_items.total_sale_price"]
+    filters = {
+      "order_items.created_date" = "30 days"
+    }
+    sorts = ["order_items.created_date desc"]
+    limit = 500
+  }
+}
@@ -0,0 +1 @@
+terraform import looker_oidc_config.main oidc_config
\ No newline at end of file
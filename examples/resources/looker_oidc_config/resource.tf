@@ -0,0 +1,23 @@
+resource "looker_oidc_config" "main" {
+  issuer                 = "https://accounts.example.com"
+  audience               = "looker"
+  identifier             = "looker"
+  secret                 = var.oidc_client_secret
+  authorization_endpoint = "https://accounts.example.com/oauth2/authorize"
+  token_endpoint         = "https://accounts.example.com/oauth2/token"
+  userinfo_endpoint      = "https://accounts.example.com/oauth2/userinfo"
+  scopes                 = ["openid", "email", "profile", "groups"]
+
+  user_attribute_map_email      = "email"
+  user_attribute_map_first_name = "given_name"
+  user_attribute_map_last_name  = "family_name"
+
+  groups_attribute      = "groups"
+  set_roles_from_groups = true
+
+  group_mapping {
+    name            = "looker-admins"
+    looker_group_id = looker_group.admins.id
+    role_ids        = [looker_role.admin.id]
+  }
+}
@@ -0,0 +1,21 @@
+resource "time_rotating" "deploy_secret" {
+  rotation_days = 90
+}
+
+resource "looker_project_deploy_webhook" "hub" {
+  project_name     = "hub"
+  rotation_trigger = time_rotating.deploy_secret.id
+}
+
+# Make the webhook available to the GitHub Actions of the LookML repository.
+resource "github_actions_secret" "looker_deploy_url" {
+  repository      = "looker-hub"
+  secret_name     = "LOOKER_DEPLOY_URL"
+  plaintext_value = looker_project_deploy_webhook.hub.webhook_url
+}
+
+resource "github_actions_secret" "looker_deploy_secret" {
+  repository      = "looker-hub"
+  secret_name     = "LOOKER_DEPLOY_SECRET"
+  plaintext_value = looker_project_deploy_webhook.hub.secret
+}
\ No newline at end of file
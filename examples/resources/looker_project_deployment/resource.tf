@@ -0,0 +1,10 @@
+variable "release" {
+  type = string
+}
+
+resource "looker_project_deployment" "hub" {
+  project_name     = "hub"
+  ref              = var.release
+  allow_warnings   = false
+  validate_content = true
+}
\ No newline at end of file
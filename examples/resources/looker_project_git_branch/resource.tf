@@ -0,0 +1,9 @@
+resource "looker_project_git_branch" "release" {
+  project_name = "hub"
+  name         = "release/1.2"
+  ref          = "v1.2.0"
+}
+
+output "unpushed_commits" {
+  value = looker_project_git_branch.release.ahead_count
+}
\ No newline at end of file
@@ -0,0 +1 @@
+terraform import looker_project_git_repo.default {{project_name}}
\ No newline at end of file
@@ -0,0 +1 @@
+terraform import looker_role_groups.default {{role_id}}
\ No newline at end of file
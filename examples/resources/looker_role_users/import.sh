@@ -0,0 +1 @@
+terraform import looker_role_users.default {{role_id}}
\ No newline at end of file
@@ -0,0 +1,4 @@
+resource "looker_role_users" "deployers" {
+  role_id  = looker_role.deployer.id
+  user_ids = ["60", "61"]
+}
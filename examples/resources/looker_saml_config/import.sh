@@ -0,0 +1 @@
+terraform import looker_saml_config.main saml_config
\ No newline at end of file
@@ -0,0 +1,32 @@
+resource "looker_saml_config" "main" {
+  idp_url     = "https://idp.example.com/sso/saml"
+  idp_issuer  = "https://idp.example.com"
+  idp_cert    = file("${path.module}/idp.pem")
+  test_config = true
+
+  user_attribute_map_email      = "email"
+  user_attribute_map_first_name = "first_name"
+  user_attribute_map_last_name  = "last_name"
+  new_user_migration_types      = ["email"]
+
+  groups_attribute      = "groups"
+  set_roles_from_groups = true
+  auth_requires_role    = true
+
+  group_mapping {
+    name            = "looker-admins"
+    looker_group_id = looker_group.admins.id
+    role_ids        = [looker_role.admin.id]
+  }
+
+  group_mapping {
+    name     = "sales"
+    role_ids = [looker_role.sales_viewer.id]
+  }
+
+  user_attribute_mapping {
+    name               = "department"
+    required           = true
+    user_attribute_ids = [looker_user_attribute.department.id]
+  }
+}
@@ -0,0 +1 @@
+terraform import looker_scheduled_plan.default {{scheduled_plan_id}}
\ No newline at end of file
@@ -0,0 +1,22 @@
+resource "looker_scheduled_plan" "daily_revenue" {
+  name     = "Daily revenue"
+  look_id  = looker_look.revenue_per_day.id
+  crontab  = "0 6 * * mon-fri"
+  timezone = "Europe/Brussels"
+
+  destination {
+    type    = "email"
+    address = "sales-team@example.com"
+    format  = "csv"
+    message = "Revenue of the last 30 days"
+  }
+
+  destination {
+    type                 = "s3"
+    address              = "s3://reporting-exports/revenue/"
+    format               = "csv"
+    s3_region            = "eu-west-1"
+    s3_access_key_id     = var.s3_access_key_id
+    s3_secret_access_key = var.s3_secret_access_key
+  }
+}
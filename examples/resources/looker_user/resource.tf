@@ -3,4 +3,13 @@ resource "looker_user" "user_a" {
   last_name           = "Waterslaeghers"
   email               = "xavier.w@ipv4.plus"
   send_password_reset = true
+}
+
+# Offboarding: unlink the SAML and Google identities and reset two-factor authentication.
+resource "looker_user" "user_b" {
+  first_name         = "Jane"
+  last_name          = "Doe"
+  email              = "jane.doe@ipv4.plus"
+  unlink_credentials = ["saml", "google"]
+  totp_reset_trigger = "2024-03-12"
 }
\ No newline at end of file
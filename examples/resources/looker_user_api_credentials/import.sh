@@ -0,0 +1 @@
+terraform import looker_user_api_credentials.default {{user_id}}:{{credentials_id}}
\ No newline at end of file
@@ -0,0 +1,18 @@
+resource "looker_user" "ci" {
+  first_name = "CI"
+  last_name  = "Service account"
+  email      = "ci@example.com"
+}
+
+resource "looker_user_api_credentials" "ci" {
+  user_id = looker_user.ci.id
+}
+
+# Use the credentials with another provider instance. As they are unknown until created,
+# create them first, e.g. with -target.
+provider "looker" {
+  alias         = "ci"
+  base_url      = "https://example.cloud.looker.com/api/"
+  client_id     = looker_user_api_credentials.ci.client_id
+  client_secret = looker_user_api_credentials.ci.client_secret
+}
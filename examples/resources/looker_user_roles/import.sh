@@ -0,0 +1 @@
+terraform import looker_user_roles.default {{user_id}}
\ No newline at end of file
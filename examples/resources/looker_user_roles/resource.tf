@@ -0,0 +1,9 @@
+// Service account created outside of Terraform
+data "looker_user" "service_account" {
+  email = "ci@example.com"
+}
+
+resource "looker_user_roles" "service_account" {
+  user_id  = data.looker_user.service_account.id
+  role_ids = [looker_role.deployer.id, "2"]
+}
@@ -0,0 +1,321 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/devoteamgcloud/terraform-provider-looker/pkg/lookergo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Schema, expand and flatten functions shared by looker_saml_config, looker_oidc_config and looker_ldap_config.
+
+// authConfigSchema returns the attributes common to the authentication configurations of the identity provider idp.
+func authConfigSchema(idp string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"enabled": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Enable " + idp + " authentication. Disabled on destroy.",
+		},
+		"alternate_email_login_allowed": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Allow alternate email-based login via '/login/email' for admins and for users with the 'login_special_email' permission.",
+		},
+		"auth_requires_role": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Users are not allowed to log in unless a role for them is found in " + idp + ".",
+		},
+		"set_roles_from_groups": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Set the roles of users from their " + idp + " groups, using `group_mapping`.",
+		},
+		"allow_normal_group_membership": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Allow users to be members of Looker groups that are not mapped from " + idp + ". If false, users are removed from such groups on login.",
+		},
+		"allow_roles_from_normal_groups": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Users inherit roles from Looker groups that are not mapped from " + idp + ".",
+		},
+		"allow_direct_roles": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Allow roles to be assigned directly to users.",
+		},
+		"default_new_user_role_ids": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "IDs of the roles given to new users the first time they log in.",
+		},
+		"default_new_user_group_ids": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "IDs of the groups new users are added to the first time they log in.",
+		},
+		"user_attribute_map_email": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Name of the " + idp + " user attribute holding the email address.",
+		},
+		"user_attribute_map_first_name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Name of the " + idp + " user attribute holding the first name.",
+		},
+		"user_attribute_map_last_name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Name of the " + idp + " user attribute holding the last name.",
+		},
+		"group_mapping": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Mappings of " + idp + " groups to Looker groups and roles.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "ID of the mapping",
+					},
+					"name": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "Name of the group in " + idp,
+					},
+					"looker_group_id": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Computed:    true,
+						Description: "ID of the `looker_group` the members of the group are added to. Looker creates a group when not set.",
+					},
+					"looker_group_name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Name of the Looker group",
+					},
+					"role_ids": {
+						Type:        schema.TypeSet,
+						Optional:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Description: "IDs of the `looker_role`s given to the members of the group",
+					},
+				},
+			},
+		},
+		"user_attribute_mapping": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "Mappings of " + idp + " user attributes to Looker user attributes.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "Name of the user attribute in " + idp,
+					},
+					"required": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     false,
+						Description: "Users are not allowed to log in when the attribute is missing",
+					},
+					"user_attribute_ids": {
+						Type:        schema.TypeSet,
+						Required:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Description: "IDs of the `looker_user_attribute`s set from the attribute",
+					},
+				},
+			},
+		},
+		"test_config": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Test the configuration with Looker before applying it. Problems found are reported as diagnostics; errors prevent the configuration from being applied.",
+		},
+		"modified_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "When the configuration was last modified",
+		},
+		"modified_by": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "ID of the user who last modified the configuration",
+		},
+	}
+}
+
+// newUserMigrationTypesSchema is the new_user_migration_types attribute of the SAML and OIDC configurations.
+func newUserMigrationTypesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+			ValidateFunc: validation.StringInSlice([]string{
+				lookergo.NewUserMigrationType_EMAIL,
+				lookergo.NewUserMigrationType_LDAP,
+				lookergo.NewUserMigrationType_OIDC,
+				lookergo.NewUserMigrationType_GOOGLE,
+				lookergo.NewUserMigrationType_SAML,
+			}, false),
+		},
+		Description: "Credential types of existing users that new logins are merged into when their email matches. Otherwise a new user is created.",
+	}
+}
+
+func expandAuthGroupMappings(l []interface{}) *[]lookergo.AuthGroupMapping {
+	mappings := make([]lookergo.AuthGroupMapping, 0, len(l))
+	for _, raw := range l {
+		obj := raw.(map[string]interface{})
+		mappings = append(mappings, lookergo.AuthGroupMapping{
+			Name:          obj["name"].(string),
+			LookerGroupId: obj["looker_group_id"].(string),
+			RoleIds:       schemaSetToStringSlice(obj["role_ids"].(*schema.Set)),
+		})
+	}
+	return &mappings
+}
+
+// flattenAuthGroupMappings flattens the group mappings in the order of the declared mappings, so that the order in
+// which Looker returns them does not cause a diff.
+func flattenAuthGroupMappings(mappings *[]lookergo.AuthGroupMapping, declared []interface{}) []interface{} {
+	if mappings == nil {
+		return []interface{}{}
+	}
+	position := make(map[string]int, len(declared))
+	for i, raw := range declared {
+		position[raw.(map[string]interface{})["name"].(string)] = i
+	}
+	sorted := append([]lookergo.AuthGroupMapping{}, *mappings...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		pi, ok := position[sorted[i].Name]
+		if !ok {
+			pi = len(declared)
+		}
+		pj, ok := position[sorted[j].Name]
+		if !ok {
+			pj = len(declared)
+		}
+		return pi < pj
+	})
+
+	l := make([]interface{}, len(sorted))
+	for i, mapping := range sorted {
+		l[i] = map[string]interface{}{
+			"id":                mapping.Id,
+			"name":              mapping.Name,
+			"looker_group_id":   mapping.LookerGroupId,
+			"looker_group_name": mapping.LookerGroupName,
+			"role_ids":          mapping.RoleIds,
+		}
+	}
+	return l
+}
+
+func expandAuthUserAttributeMappings(s *schema.Set) *[]lookergo.AuthUserAttributeMapping {
+	mappings := make([]lookergo.AuthUserAttributeMapping, 0, s.Len())
+	for _, raw := range s.List() {
+		obj := raw.(map[string]interface{})
+		mappings = append(mappings, lookergo.AuthUserAttributeMapping{
+			Name:             obj["name"].(string),
+			Required:         obj["required"].(bool),
+			UserAttributeIds: schemaSetToStringSlice(obj["user_attribute_ids"].(*schema.Set)),
+		})
+	}
+	return &mappings
+}
+
+func flattenAuthUserAttributeMappings(mappings *[]lookergo.AuthUserAttributeMapping) []interface{} {
+	if mappings == nil {
+		return []interface{}{}
+	}
+	l := make([]interface{}, len(*mappings))
+	for i, mapping := range *mappings {
+		l[i] = map[string]interface{}{
+			"name":               mapping.Name,
+			"required":           mapping.Required,
+			"user_attribute_ids": mapping.UserAttributeIds,
+		}
+	}
+	return l
+}
+
+func expandNewUserMigrationTypes(s *schema.Set) *string {
+	types := schemaSetToStringSlice(s)
+	sort.Strings(types)
+	return castToPtr(strings.Join(types, ","))
+}
+
+func flattenNewUserMigrationTypes(types *string) []string {
+	if types == nil || *types == "" {
+		return []string{}
+	}
+	return strings.Split(*types, ",")
+}
+
+func flattenRoleIds(roles *[]lookergo.Role) []string {
+	ids := []string{}
+	if roles != nil {
+		for _, role := range *roles {
+			ids = append(ids, strconv.Itoa(role.Id))
+		}
+	}
+	return ids
+}
+
+func flattenGroupIds(groups *[]lookergo.Group) []string {
+	ids := []string{}
+	if groups != nil {
+		for _, group := range *groups {
+			ids = append(ids, strconv.Itoa(group.Id))
+		}
+	}
+	return ids
+}
+
+// importAuthConfig imports the singleton authentication configuration with the fixed id.
+func importAuthConfig(id string) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+			d.SetId(id)
+			return []*schema.ResourceData{d}, nil
+		},
+	}
+}
+
+// setAuthConfigAttributes sets the attributes in values, stopping at the first error.
+func setAuthConfigAttributes(d *schema.ResourceData, values map[string]interface{}) diag.Diagnostics {
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.Errorf("setting %s: %v", key, err)
+		}
+	}
+	return nil
+}
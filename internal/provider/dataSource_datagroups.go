@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceDatagroups() *schema.Resource {
+	return &schema.Resource{
+		Description: "Lists the datagroups, sorted by model and name. Timestamps are in seconds since the epoch.",
+		ReadContext: dataSourceDatagroupsRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"model_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return the datagroups of this model.",
+			},
+			"datagroups": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Datagroups.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"model_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"triggered_at": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"stale_before": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"trigger_check_at": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"trigger_value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"trigger_error": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDatagroupsRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+
+	tflog.Info(ctx, "Querying Looker Datagroups")
+	datagroups, _, err := c.Datagroups.List(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	sort.Slice(datagroups, func(i, j int) bool {
+		if datagroups[i].ModelName != datagroups[j].ModelName {
+			return datagroups[i].ModelName < datagroups[j].ModelName
+		}
+		return datagroups[i].Name < datagroups[j].Name
+	})
+
+	modelName := d.Get("model_name").(string)
+	items := []interface{}{}
+	for _, datagroup := range datagroups {
+		if modelName != "" && datagroup.ModelName != modelName {
+			continue
+		}
+		items = append(items, map[string]interface{}{
+			"id":               datagroup.Id,
+			"model_name":       datagroup.ModelName,
+			"name":             datagroup.Name,
+			"created_at":       int(datagroup.CreatedAt),
+			"triggered_at":     int(datagroup.TriggeredAt),
+			"stale_before":     int(datagroup.StaleBefore),
+			"trigger_check_at": int(datagroup.TriggerCheckAt),
+			"trigger_value":    datagroup.TriggerValue,
+			"trigger_error":    datagroup.TriggerError,
+		})
+	}
+	if err = d.Set("datagroups", items); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("-")
+
+	return diags
+}
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"sort"
+
+	"github.com/devoteamgcloud/terraform-provider-looker/pkg/lookergo"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFolders() *schema.Resource {
+	return &schema.Resource{
+		Description: `Lists the folders matching all the given filters, sorted by ID.
+
+The name filter supports the wildcards ` + "`%`" + ` (any characters) and ` + "`_`" + ` (any single character).
+`,
+		ReadContext: dataSourceFoldersRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filter on the name.",
+			},
+			"parent_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return the subfolders of this folder.",
+			},
+			"creator_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return the folders created by this user.",
+			},
+			"ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "IDs of the folders.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"folders": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Folders.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"parent_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"creator_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"child_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceFoldersRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+
+	search := &lookergo.FolderSearch{
+		Name:      d.Get("name").(string),
+		ParentId:  d.Get("parent_id").(string),
+		CreatorId: d.Get("creator_id").(string),
+	}
+	tflog.Info(ctx, "Querying Looker Folders", map[string]interface{}{"search": search})
+	folders, _, err := c.Folders.Search(ctx, search, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	sort.Slice(folders, func(i, j int) bool { return lessId(folders[i].Id, folders[j].Id) })
+
+	ids := make([]string, len(folders))
+	items := make([]interface{}, len(folders))
+	for i, folder := range folders {
+		ids[i] = folder.Id
+		items[i] = map[string]interface{}{
+			"id":          folder.Id,
+			"name":        folder.Name,
+			"parent_id":   folder.ParentId,
+			"creator_id":  folder.CreatorId,
+			"child_count": int(folder.ChildCount),
+		}
+	}
+	if err = d.Set("ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("folders", items); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("-")
+
+	return diags
+}
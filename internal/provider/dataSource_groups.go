@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	"github.com/devoteamgcloud/terraform-provider-looker/pkg/lookergo"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGroups() *schema.Resource {
+	return &schema.Resource{
+		Description: `Lists the groups matching all the given filters, sorted by ID.
+
+The name filter supports the wildcards ` + "`%`" + ` (any characters) and ` + "`_`" + ` (any single character).
+`,
+		ReadContext: dataSourceGroupsRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filter on the name.",
+			},
+			"externally_managed": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Only return the groups managed (`true`) or not managed (`false`) by an external directory.",
+			},
+			"parent_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return the direct member groups of this group.",
+			},
+			"ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "IDs of the groups.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"groups": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Groups.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"externally_managed": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"parent_group_ids": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"role_ids": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGroupsRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+
+	search := &lookergo.GroupSearch{
+		Name:              d.Get("name").(string),
+		ExternallyManaged: optionalBool(d, "externally_managed"),
+	}
+	tflog.Info(ctx, "Querying Looker Groups", map[string]interface{}{"search": search})
+	groups, _, err := c.Groups.Search(ctx, search, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i].Id < groups[j].Id })
+
+	// The search endpoint can't filter on the parent group.
+	parentId, filterParent := d.GetOk("parent_id")
+	ids := []string{}
+	items := []interface{}{}
+	for _, group := range groups {
+		parentIds := group.ParentGroupIds.ToSliceOfStrings()
+		if filterParent && !contains(parentIds, parentId.(string)) {
+			continue
+		}
+		ids = append(ids, strconv.Itoa(group.Id))
+		items = append(items, map[string]interface{}{
+			"id":                 strconv.Itoa(group.Id),
+			"name":               group.Name,
+			"user_count":         group.UserCount,
+			"externally_managed": group.ExternallyManaged,
+			"parent_group_ids":   parentIds,
+			"role_ids":           group.RoleIds.ToSliceOfStrings(),
+		})
+	}
+	if err = d.Set("ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("groups", items); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("-")
+
+	return diags
+}
@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"context"
+	"github.com/devoteamgcloud/terraform-provider-looker/pkg/lookergo"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	lookKey = []string{
+		"id",
+		"title",
+	}
+)
+
+func dataSourceLook() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLookRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description:  "Search look based on id.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: lookKey,
+			},
+			"title": {
+				Description:  "Search look based on title.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: lookKey,
+			},
+			"folder_id": {
+				Description: "Id of the folder the look is in.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"description": {
+				Description: "Description of the look.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"is_run_on_load": {
+				Description: "Whether the query runs when the look is viewed.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"public": {
+				Description: "Whether the look is public.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"query_id": {
+				Description: "Id of the query of the look.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"user_id": {
+				Description: "Id of the user who owns the look.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"query": {
+				Description: "Query of the look.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"model":             {Type: schema.TypeString, Computed: true},
+						"view":              {Type: schema.TypeString, Computed: true},
+						"fields":            {Type: schema.TypeList, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}},
+						"filters":           {Type: schema.TypeMap, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}},
+						"filter_expression": {Type: schema.TypeString, Computed: true},
+						"sorts":             {Type: schema.TypeList, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}},
+						"pivots":            {Type: schema.TypeList, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}},
+						"limit":             {Type: schema.TypeInt, Computed: true},
+						"total":             {Type: schema.TypeBool, Computed: true},
+						"dynamic_fields":    {Type: schema.TypeString, Computed: true},
+						"query_timezone":    {Type: schema.TypeString, Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLookRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+	tflog.Info(ctx, "Querying Looker Look")
+
+	var lookId string
+	if id, exists := d.GetOk("id"); exists { // Query using ID
+		lookId = id.(string)
+	} else if title, exists := d.GetOk("title"); exists { // Query using Title
+		looks, _, err := c.Looks.ListByTitle(ctx, title.(string), &lookergo.ListOptions{})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		for _, look := range looks {
+			if look.Title == title.(string) {
+				lookId = look.Id
+				break
+			}
+		}
+		if lookId == "" {
+			return diag.Errorf("Look not found.")
+		}
+	} else {
+		return diag.Errorf("Neither title, nor id provided.")
+	}
+
+	look, _, err := c.Looks.Get(ctx, lookId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	query := look.Query
+	if query == nil {
+		if query, _, err = c.Queries.Get(ctx, look.QueryId); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(look.Id)
+	d.Set("title", look.Title)
+	d.Set("folder_id", look.FolderId)
+	d.Set("description", valueFromPtr(look.Description))
+	d.Set("is_run_on_load", valueFromPtr(look.IsRunOnLoad))
+	d.Set("public", valueFromPtr(look.Public))
+	d.Set("query_id", look.QueryId)
+	d.Set("user_id", look.UserId)
+	if err = d.Set("query", flattenLookQuery(query)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
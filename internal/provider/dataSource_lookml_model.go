@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/devoteamgcloud/terraform-provider-looker/pkg/lookergo"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// lookMlModelSchema returns the computed attributes of a model, shared by the looker_lookml_model and
+// looker_lookml_models data sources.
+func lookMlModelSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"project_name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Name of the project containing the model.",
+		},
+		"label": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "UI-friendly name of the model.",
+		},
+		"has_content": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the model declaration has LookML content.",
+		},
+		"allowed_db_connection_names": {
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Description: "Names of the connections the model is allowed to use.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"unlimited_db_connections": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the model is allowed to use all current and future connections.",
+		},
+		"explores": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Explores of the model.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"label": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"description": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"group_label": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"hidden": {
+						Type:     schema.TypeBool,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// flattenLookMlModel returns the attributes of lookMlModelSchema for model.
+func flattenLookMlModel(model *lookergo.LookMLModel) map[string]interface{} {
+	explores := []interface{}{}
+	if model.Explores != nil {
+		for _, explore := range *model.Explores {
+			explores = append(explores, map[string]interface{}{
+				"name":        valueFromPtr(explore.Name),
+				"label":       valueFromPtr(explore.Label),
+				"description": valueFromPtr(explore.Description),
+				"group_label": valueFromPtr(explore.GroupLabel),
+				"hidden":      valueFromPtr(explore.Hidden),
+			})
+		}
+	}
+	return map[string]interface{}{
+		"project_name":                model.ProjectName,
+		"label":                       model.Label,
+		"has_content":                 model.HasContent,
+		"allowed_db_connection_names": model.AllowedDbConnectionNames,
+		"unlimited_db_connections":    model.UnlimitedDbConnections,
+		"explores":                    explores,
+	}
+}
+
+func dataSourceLookMlModel() *schema.Resource {
+	s := lookMlModelSchema()
+	s["name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Name of the model.",
+	}
+	return &schema.Resource{
+		Description: "Reads a LookML model and its explores.",
+		ReadContext: dataSourceLookMlModelRead,
+		Schema:      s,
+	}
+}
+
+func dataSourceLookMlModelRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+
+	name := d.Get("name").(string)
+	tflog.Info(ctx, "Querying Looker LookML Model", map[string]interface{}{"name": name})
+	model, _, err := c.LookMLModel.Get(ctx, name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	for key, value := range flattenLookMlModel(model) {
+		if err = d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	d.SetId(model.Name)
+
+	return diags
+}
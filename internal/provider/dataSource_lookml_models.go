@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceLookMlModels() *schema.Resource {
+	model := lookMlModelSchema()
+	model["name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+	return &schema.Resource{
+		Description: "Lists the LookML models, sorted by name, e.g. to give a `looker_model_set` every model of a project.",
+		ReadContext: dataSourceLookMlModelsRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return the models of this project.",
+			},
+			"exclude_empty": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to leave out the models without LookML content.",
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Names of the models.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"models": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Models.",
+				Elem:        &schema.Resource{Schema: model},
+			},
+		},
+	}
+}
+
+func dataSourceLookMlModelsRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+
+	tflog.Info(ctx, "Querying Looker LookML Models")
+	models, _, err := c.LookMLModel.List(ctx, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	sort.Slice(models, func(i, j int) bool { return models[i].Name < models[j].Name })
+
+	projectName := d.Get("project_name").(string)
+	excludeEmpty := d.Get("exclude_empty").(bool)
+	names := []string{}
+	items := []interface{}{}
+	for _, model := range models {
+		if projectName != "" && model.ProjectName != projectName || excludeEmpty && !model.HasContent {
+			continue
+		}
+		item := flattenLookMlModel(&model)
+		item["name"] = model.Name
+		names = append(names, model.Name)
+		items = append(items, item)
+	}
+	if err = d.Set("names", names); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("models", items); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("-")
+
+	return diags
+}
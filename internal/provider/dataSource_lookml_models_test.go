@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDataSourceLookMlModels(t *testing.T) {
+	mux, config := setupMockServer(t)
+	mux.HandleFunc("/api/4.0/lookml_models", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[
+			{"name":"sales","project_name":"hub","has_content":true,"explores":[{"name":"orders","label":"Orders"}]},
+			{"name":"marketing","project_name":"hub","has_content":true},
+			{"name":"draft","project_name":"hub"},
+			{"name":"finance","project_name":"finance","has_content":true}
+		]`)
+	})
+	dataSource := dataSourceLookMlModels()
+
+	d := schema.TestResourceDataRaw(t, dataSource.Schema, map[string]interface{}{
+		"project_name":  "hub",
+		"exclude_empty": true,
+	})
+	if diags := dataSource.ReadContext(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("read returned error: %v", diags)
+	}
+	if got := interfaceListToStringList(d.Get("names").([]interface{})); !reflect.DeepEqual(got, []string{"marketing", "sales"}) {
+		t.Errorf("names = %v, expected [marketing sales]", got)
+	}
+	if d.Get("models.1.explores.0.label") != "Orders" {
+		t.Errorf("models.1.explores = %v, expected the orders explore", d.Get("models.1.explores"))
+	}
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceProjectGitBranches() *schema.Resource {
+	branch := gitBranchSchema()
+	branch["name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+	branch["owner_name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+	return &schema.Resource{
+		Description: "Lists the git branches of a project, sorted by name.",
+		ReadContext: dataSourceProjectGitBranchesRead,
+		Schema: map[string]*schema.Schema{
+			"project_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the project.",
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Names of the branches.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"branches": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Branches.",
+				Elem:        &schema.Resource{Schema: branch},
+			},
+		},
+	}
+}
+
+func dataSourceProjectGitBranchesRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	if err := ensureDevClient(ctx, m); err != nil {
+		return diagErrAppend(diags, err)
+	}
+	dc := m.(*Config).DevClient
+	if err := dc.EnsureStaticToken(ctx, m.(*Config).Api, m.(*Config).ApiUserID); err != nil {
+		return diagErrAppend(diags, err)
+	}
+
+	projectName := d.Get("project_name").(string)
+	tflog.Info(ctx, "Querying Looker git branches", map[string]interface{}{"project": projectName})
+	branches, _, err := dc.Projects.GitBranchesList(ctx, projectName, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	sort.Slice(branches, func(i, j int) bool { return branches[i].Name < branches[j].Name })
+
+	names := make([]string, len(branches))
+	items := make([]interface{}, len(branches))
+	for i, branch := range branches {
+		item := flattenGitBranch(&branch)
+		item["name"] = branch.Name
+		item["owner_name"] = branch.OwnerName
+		names[i] = branch.Name
+		items[i] = item
+	}
+	if err = d.Set("names", names); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("branches", items); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(projectName)
+
+	return diags
+}
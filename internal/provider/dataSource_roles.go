@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	"github.com/devoteamgcloud/terraform-provider-looker/pkg/lookergo"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceRoles() *schema.Resource {
+	return &schema.Resource{
+		Description: `Lists the roles matching all the given filters, sorted by ID.
+
+The name filter supports the wildcards ` + "`%`" + ` (any characters) and ` + "`_`" + ` (any single character).
+`,
+		ReadContext: dataSourceRolesRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filter on the name.",
+			},
+			"built_in": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Only return the built-in (`true`) or custom (`false`) roles.",
+			},
+			"ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "IDs of the roles.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"roles": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Roles.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"permission_set_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"model_set_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRolesRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+
+	search := &lookergo.RoleSearch{
+		Name:    d.Get("name").(string),
+		BuiltIn: optionalBool(d, "built_in"),
+	}
+	tflog.Info(ctx, "Querying Looker Roles", map[string]interface{}{"search": search})
+	roles, _, err := c.Roles.Search(ctx, search, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	sort.Slice(roles, func(i, j int) bool { return roles[i].Id < roles[j].Id })
+
+	ids := make([]string, len(roles))
+	items := make([]interface{}, len(roles))
+	for i, role := range roles {
+		ids[i] = strconv.Itoa(role.Id)
+		items[i] = map[string]interface{}{
+			"id":                strconv.Itoa(role.Id),
+			"name":              role.Name,
+			"permission_set_id": role.PermissionSet.Id,
+			"model_set_id":      role.ModelSet.Id,
+		}
+	}
+	if err = d.Set("ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("roles", items); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("-")
+
+	return diags
+}
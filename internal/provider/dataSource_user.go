@@ -16,7 +16,7 @@ var (
 )
 
 func dataSourceUser() *schema.Resource {
-	return &schema.Resource{
+	resource := &schema.Resource{
 		ReadContext: dataSourceUserRead,
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -46,6 +46,10 @@ func dataSourceUser() *schema.Resource {
 			},
 		},
 	}
+	for key, credentialsSchema := range userCredentialsSchema() {
+		resource.Schema[key] = credentialsSchema
+	}
+	return resource
 }
 
 func dataSourceUserRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
@@ -98,6 +102,10 @@ func dataSourceUserRead(ctx context.Context, d *schema.ResourceData, m interface
 		return diag.FromErr(err)
 	}
 
+	if err := setUserCredentials(d, &user); err != nil {
+		return diag.FromErr(err)
+	}
+
 	d.SetId(user.Id)
 
 	return diags
@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/devoteamgcloud/terraform-provider-looker/pkg/lookergo"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceUsers() *schema.Resource {
+	return &schema.Resource{
+		Description: `Lists the users matching all the given filters, sorted by ID.
+
+The name and email filters support the wildcards ` + "`%`" + ` (any characters) and ` + "`_`" + ` (any single character).
+`,
+		ReadContext: dataSourceUsersRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"first_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filter on the first name.",
+			},
+			"last_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filter on the last name.",
+			},
+			"email": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Filter on the email address.",
+				ConflictsWith: []string{"email_domain"},
+			},
+			"email_domain": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Only return the users with an email address in this domain, e.g. `example.com`.",
+				ConflictsWith: []string{"email"},
+			},
+			"is_disabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Only return disabled (`true`) or enabled (`false`) users.",
+			},
+			"group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return the direct members of this group.",
+			},
+			"ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "IDs of the users.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"users": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Users.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"first_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_disabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"group_ids": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"role_ids": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"personal_folder_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceUsersRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+
+	search := &lookergo.UserSearch{
+		FirstName:  d.Get("first_name").(string),
+		LastName:   d.Get("last_name").(string),
+		Email:      d.Get("email").(string),
+		IsDisabled: optionalBool(d, "is_disabled"),
+		GroupId:    d.Get("group_id").(string),
+	}
+	if domain, ok := d.GetOk("email_domain"); ok {
+		search.Email = fmt.Sprintf("%%@%s", domain)
+	}
+	tflog.Info(ctx, "Querying Looker Users", map[string]interface{}{"search": search})
+	users, _, err := c.Users.Search(ctx, search, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	sort.Slice(users, func(i, j int) bool { return lessId(users[i].Id, users[j].Id) })
+
+	ids := make([]string, len(users))
+	items := make([]interface{}, len(users))
+	for i, user := range users {
+		ids[i] = user.Id
+		items[i] = map[string]interface{}{
+			"id":                 user.Id,
+			"email":              user.Email,
+			"first_name":         user.FirstName,
+			"last_name":          user.LastName,
+			"display_name":       user.DisplayName,
+			"is_disabled":        user.IsDisabled,
+			"group_ids":          user.GroupIds,
+			"role_ids":           user.RoleIds.ToSliceOfStrings(),
+			"personal_folder_id": user.PersonalFolderId,
+		}
+	}
+	if err = d.Set("ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("users", items); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("-")
+
+	return diags
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDataSourceUsers(t *testing.T) {
+	mux, config := setupMockServer(t)
+	mux.HandleFunc("/api/4.0/users/search", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query(); got.Get("email") != "%@example.com" || got.Get("is_disabled") != "false" {
+			t.Errorf("unexpected search: %v", got)
+		}
+		fmt.Fprint(w, `[{"id":"10","email":"john@example.com"},{"id":"9","email":"jane@example.com","role_ids":["2"]}]`)
+	})
+	dataSource := dataSourceUsers()
+
+	d := schema.TestResourceDataRaw(t, dataSource.Schema, map[string]interface{}{
+		"email_domain": "example.com",
+		"is_disabled":  false,
+	})
+	if diags := dataSource.ReadContext(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("read returned error: %v", diags)
+	}
+	if got := interfaceListToStringList(d.Get("ids").([]interface{})); !reflect.DeepEqual(got, []string{"9", "10"}) {
+		t.Errorf("ids = %v, expected [9 10]", got)
+	}
+	if d.Get("users.0.email") != "jane@example.com" || d.Get("users.0.role_ids.#") != 1 {
+		t.Errorf("unexpected first user: %v", d.Get("users.0"))
+	}
+}
+
+func TestDataSourceGroups(t *testing.T) {
+	mux, config := setupMockServer(t)
+	mux.HandleFunc("/api/4.0/groups/search/with_hierarchy", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("name"); got != "Sales%" {
+			t.Errorf("name = %q, expected Sales%%", got)
+		}
+		fmt.Fprint(w, `[{"id":"12","name":"Sales US","parent_group_ids":["1"]},{"id":"4","name":"Sales EMEA","parent_group_ids":["1"]},{"id":"1","name":"Sales"}]`)
+	})
+	dataSource := dataSourceGroups()
+
+	d := schema.TestResourceDataRaw(t, dataSource.Schema, map[string]interface{}{
+		"name":      "Sales%",
+		"parent_id": "1",
+	})
+	if diags := dataSource.ReadContext(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("read returned error: %v", diags)
+	}
+	if got := interfaceListToStringList(d.Get("ids").([]interface{})); !reflect.DeepEqual(got, []string{"4", "12"}) {
+		t.Errorf("ids = %v, expected [4 12]", got)
+	}
+	if d.Get("groups.1.name") != "Sales US" {
+		t.Errorf("groups.1.name = %v, expected Sales US", d.Get("groups.1.name"))
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"regexp"
 	"runtime"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -43,6 +44,20 @@ func idAsInt(val interface{}) int {
 	return ret
 }
 
+// buildTwoPartId joins the ids of a resource that is identified by two objects, e.g. "5:60" for user 60 in group 5.
+func buildTwoPartId(first, second string) string {
+	return first + ":" + second
+}
+
+// parseTwoPartId splits an id built by buildTwoPartId. The names of both parts are used in the error message.
+func parseTwoPartId(id, firstName, secondName string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of id %q, expected %s:%s", id, firstName, secondName)
+	}
+	return parts[0], parts[1], nil
+}
+
 func currFuncName() string {
 	counter, _, _, success := runtime.Caller(1)
 
@@ -61,6 +76,32 @@ func boolPtr(b bool) *bool {
 	return &b
 }
 
+// valueFromPtr returns the value p points to, or the zero value of T if p is nil.
+func valueFromPtr[T any](p *T) (v T) {
+	if p != nil {
+		v = *p
+	}
+	return
+}
+
+// optionalBool returns a pointer to the value of the boolean key, or nil if it is not set.
+func optionalBool(d *schema.ResourceData, key string) *bool {
+	if value, ok := d.GetOkExists(key); ok {
+		return boolPtr(value.(bool))
+	}
+	return nil
+}
+
+// lessId orders ids numerically when both are numbers, and lexically otherwise.
+func lessId(a, b string) bool {
+	x, errA := strconv.Atoi(a)
+	y, errB := strconv.Atoi(b)
+	if errA == nil && errB == nil {
+		return x < y
+	}
+	return a < b
+}
+
 func logTrace(ctx context.Context, msg string, additional ...any) {
 	add := make(map[string]interface{})
 	pc, _, _, ok := runtime.Caller(1)
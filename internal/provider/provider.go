@@ -7,6 +7,8 @@ import (
 	"net/url"
 	"reflect"
 	"strings"
+	"sync"
+	"time"
 
 	md "github.com/JohannesKaufmann/html-to-markdown"
 	"github.com/devoteamgcloud/terraform-provider-looker/pkg/lookergo"
@@ -16,6 +18,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func init() {
@@ -58,23 +61,110 @@ func New(version string) func() *schema.Provider {
 					Sensitive:   true,
 					DefaultFunc: schema.EnvDefaultFunc("LOOKER_API_CLIENT_SECRET", nil),
 				},
+				"access_token": {
+					Description: "API access token, used instead of `client_id` and `client_secret`. " +
+						"It isn't refreshed: the token has to outlive the Terraform run.",
+					Type:        schema.TypeString,
+					Optional:    true,
+					Sensitive:   true,
+					DefaultFunc: schema.EnvDefaultFunc("LOOKER_ACCESS_TOKEN", nil),
+				},
+				"config_path": {
+					Description: "Path of a `looker.ini` file, in the format of the official Looker SDKs. " +
+						"Its `base_url`, `client_id`, `client_secret`, `verify_ssl` and `timeout` (in seconds) are used when not set on the provider, " +
+						"overridden by the `LOOKERSDK_*` environment variables of the SDKs (e.g. `LOOKERSDK_CLIENT_SECRET`), which are read without it too.",
+					Type:        schema.TypeString,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("LOOKER_CONFIG_PATH", nil),
+				},
+				"config_section": {
+					Description: "Section of the `config_path` file to read, e.g. the profile of an environment.",
+					Type:        schema.TypeString,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("LOOKER_CONFIG_SECTION", lookergo.DefaultApiSettingsSection),
+				},
+				"verify_ssl": {
+					Description: "Verify the TLS certificate of the instance. Defaults to true.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("LOOKER_VERIFY_SSL", nil),
+				},
+				"ca_cert_file": {
+					Description: "Path of a PEM file with the certificate authorities to trust besides the ones of the system, " +
+						"e.g. for a self-hosted instance with an internal certificate.",
+					Type:        schema.TypeString,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("LOOKER_CA_CERT_FILE", nil),
+				},
+				"max_requests_per_second": {
+					Description: "Maximum number of API requests per second sent by the provider. " +
+						"The rate is lowered automatically when Looker answers with 429 Too Many Requests. " +
+						"Set to 0 to disable the limit.",
+					Type:         schema.TypeFloat,
+					Optional:     true,
+					DefaultFunc:  schema.EnvDefaultFunc("LOOKER_MAX_REQUESTS_PER_SECOND", float64(lookergo.DefaultRequestsPerSecond)),
+					ValidateFunc: validation.FloatAtLeast(0),
+				},
+				"max_attempts": {
+					Description: "Maximum number of attempts for a single API request, including the first one. " +
+						"Requests failing with 429, a 5xx error or a transient network error are retried. " +
+						"Set to 1 to disable retries.",
+					Type:         schema.TypeInt,
+					Optional:     true,
+					DefaultFunc:  schema.EnvDefaultFunc("LOOKER_MAX_ATTEMPTS", 3),
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"retry_wait_min": {
+					Description:  "Wait in seconds before the first retry. The wait doubles on every following retry.",
+					Type:         schema.TypeInt,
+					Optional:     true,
+					DefaultFunc:  schema.EnvDefaultFunc("LOOKER_RETRY_WAIT_MIN", 1),
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"retry_wait_max": {
+					Description:  "Maximum wait in seconds between two retries, also capping the wait asked for by a `Retry-After` header sent by Looker.",
+					Type:         schema.TypeInt,
+					Optional:     true,
+					DefaultFunc:  schema.EnvDefaultFunc("LOOKER_RETRY_WAIT_MAX", 30),
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"retry_idempotent_writes": {
+					Description: "Also retry PATCH, PUT and DELETE requests. By default only GET, HEAD and OPTIONS requests are retried.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("LOOKER_RETRY_IDEMPOTENT_WRITES", false),
+				},
 			},
 			DataSourcesMap: map[string]*schema.Resource{
-				"looker_user":                dataSourceUser(),
-				"looker_group":               dataSourceGroup(),
-				"looker_project":             dataSourceProject(),
-				"looker_folder":              dataSourceFolder(),
-				"looker_permission_set":      dataSourcePermissionSet(),
-				"looker_role":                dataSourceRole(),
-				"looker_user_attribute":      dataSourceUserAttribute(),
-				"looker_public_ip_addresses": dataSourcePublicEgressIps(),
+				"looker_user":                 dataSourceUser(),
+				"looker_group":                dataSourceGroup(),
+				"looker_project":              dataSourceProject(),
+				"looker_folder":               dataSourceFolder(),
+				"looker_permission_set":       dataSourcePermissionSet(),
+				"looker_role":                 dataSourceRole(),
+				"looker_user_attribute":       dataSourceUserAttribute(),
+				"looker_public_ip_addresses":  dataSourcePublicEgressIps(),
+				"looker_look":                 dataSourceLook(),
+				"looker_users":                dataSourceUsers(),
+				"looker_groups":               dataSourceGroups(),
+				"looker_roles":                dataSourceRoles(),
+				"looker_folders":              dataSourceFolders(),
+				"looker_lookml_model":         dataSourceLookMlModel(),
+				"looker_lookml_models":        dataSourceLookMlModels(),
+				"looker_datagroups":           dataSourceDatagroups(),
+				"looker_project_git_branches": dataSourceProjectGitBranches(),
 			},
 			ResourcesMap: map[string]*schema.Resource{
 				"looker_user":                   resourceUser(),
 				"looker_group":                  resourceGroup(),
 				"looker_group_member":           resourceGroupMember(),
+				"looker_group_user":             resourceGroupUser(),
+				"looker_group_group":            resourceGroupGroup(),
 				"looker_role":                   resourceRole(),
 				"looker_role_groups":            resourceRoleGroups(),
+				"looker_role_users":             resourceRoleUsers(),
+				"looker_user_roles":             resourceUserRoles(),
+				"looker_user_api_credentials":   resourceUserApiCredentials(),
 				"looker_connection":             resourceConnection(),
 				"looker_project":                resourceProject(),
 				"looker_project_git_deploy_key": resourceProjectGitDeployKey(),
@@ -88,6 +178,17 @@ func New(version string) func() *schema.Provider {
 				"looker_user_attribute":         resourceUserAttribute(),
 				"looker_user_attribute_member":  resourceUserAttributeMember(),
 				"looker_theme":                  resourceTheme(),
+				"looker_dashboard":              resourceDashboard(),
+				"looker_look":                   resourceLook(),
+				"looker_scheduled_plan":         resourceScheduledPlan(),
+				"looker_folder_access":          resourceFolderAccess(),
+				"looker_saml_config":            resourceSamlConfig(),
+				"looker_oidc_config":            resourceOIDCConfig(),
+				"looker_ldap_config":            resourceLDAPConfig(),
+				"looker_datagroup_trigger":      resourceDatagroupTrigger(),
+				"looker_project_deployment":     resourceProjectDeployment(),
+				"looker_project_git_branch":     resourceProjectGitBranch(),
+				"looker_project_deploy_webhook": resourceProjectDeployWebhook(),
 			},
 		}
 
@@ -112,6 +213,16 @@ type Config struct {
 	DevClient                 *lookergo.Client
 	Workspace                 Workspace
 	RequestCompletionCallback lookergo.RequestCompletionCallback
+
+	// devClientMu guards the creation of DevClient by concurrent resource operations.
+	devClientMu sync.Mutex
+}
+
+// Shutdown logs out the dev sessions opened by the providers served by this process, and returns the last error met.
+// It is meant to be called once Terraform is done with the plugin. Logging out is best-effort: Terraform may stop the
+// plugin before it runs, in which case the sessions expire on their own on the instance.
+func Shutdown(ctx context.Context) error {
+	return lookergo.CloseDevSessions(ctx)
 }
 
 func providerConfigure(ctx context.Context, d *schema.ResourceData, p *schema.Provider, version string) (interface{}, diag.Diagnostics) {
@@ -125,6 +236,52 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, p *schema.Pr
 	devClient := lookergo.NewClient(nil)
 
 	old_url := d.Get("base_url").(string)
+	clientId := d.Get("client_id").(string)
+	clientSecret := d.Get("client_secret").(string)
+	verifySSL := optionalBool(d, "verify_ssl")
+
+	// Settings of the provider take precedence over the LOOKERSDK_* environment variables, which take precedence over
+	// the ones of the ini file.
+	settings := new(lookergo.ApiSettings)
+	if configPath, ok := d.GetOk("config_path"); ok {
+		var err error
+		if settings, err = lookergo.LoadApiSettings(configPath.(string), d.Get("config_section").(string)); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to read looker.ini file",
+				Detail:   "Err: " + err.Error(),
+			})
+			return nil, diags
+		}
+	}
+	if err := settings.ApplyEnv(); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read looker SDK environment variables",
+			Detail:   "Err: " + err.Error(),
+		})
+		return nil, diags
+	}
+	if old_url == "" {
+		old_url = settings.BaseURL
+	}
+	if clientId == "" {
+		clientId = settings.ClientId
+	}
+	if clientSecret == "" {
+		clientSecret = settings.ClientSecret
+	}
+	if verifySSL == nil {
+		verifySSL = settings.VerifySSL
+	}
+	if old_url == "" {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Missing looker API base_url",
+			Detail:   "Set base_url, LOOKER_BASE_URL, LOOKERSDK_BASE_URL or the base_url of the config_path file.",
+		})
+		return nil, diags
+	}
 
 	newURL := strings.TrimSuffix(old_url, "/")
 	if !strings.HasSuffix(newURL, "/api") {
@@ -132,19 +289,66 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, p *schema.Pr
 	} else {
 		newURL += "/"
 	}
-	if err := client.SetBaseURL(newURL); err != nil {
+	for _, c := range []*lookergo.Client{client, devClient} {
+		if err := c.SetBaseURL(newURL); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to set looker API endpoint",
+				Detail:   "Err: " + err.Error(),
+			})
+			return nil, diags
+		}
+	}
+
+	if caCertFile := d.Get("ca_cert_file").(string); caCertFile != "" || (verifySSL != nil && !*verifySSL) {
+		tlsConfig, err := lookergo.NewTLSConfig(verifySSL == nil || *verifySSL, caCertFile)
+		if err == nil {
+			err = client.SetTLSConfig(tlsConfig)
+		}
+		if err == nil {
+			err = devClient.SetTLSConfig(tlsConfig)
+		}
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to set looker API TLS settings",
+				Detail:   "Err: " + err.Error(),
+			})
+			return nil, diags
+		}
+	}
+
+	if settings.Timeout != 0 {
+		timeout := time.Duration(settings.Timeout) * time.Second
+		for _, c := range []*lookergo.Client{client, devClient} {
+			if err := c.SetTimeout(timeout); err != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Unable to set looker API timeout",
+					Detail:   "Err: " + err.Error(),
+				})
+				return nil, diags
+			}
+		}
+	}
+
+	if accessToken := d.Get("access_token").(string); accessToken != "" {
+		if err := client.SetOauthStaticToken(ctx, &oauth2.Token{AccessToken: accessToken}); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to set looker API access_token",
+				Detail:   "Err: " + err.Error(),
+			})
+			return nil, diags
+		}
+	} else if clientId == "" || clientSecret == "" {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
-			Summary:  "Unable to set looker API endpoint",
-			Detail:   "Err: " + err.Error(),
+			Summary:  "Missing looker API credentials",
+			Detail:   "Set access_token, or client_id and client_secret, on the provider or in the config_path file.",
 		})
 		return nil, diags
-	}
-	devClient.SetBaseURL(newURL)
-
-	clientId := d.Get("client_id").(string)
-	clientSecret := d.Get("client_secret").(string)
-	if err := client.SetOauthCredentials(ctx, clientId, clientSecret); err != nil {
+	} else if err := client.SetOauthCredentials(ctx, clientId, clientSecret); err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to set looker API client_id/client_secret",
@@ -162,6 +366,33 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, p *schema.Pr
 	}
 	devClient.SetUserAgent(userAgent)
 
+	retryPolicy := lookergo.RetryPolicy{
+		MaxAttempts:           d.Get("max_attempts").(int),
+		MinBackoff:            time.Duration(d.Get("retry_wait_min").(int)) * time.Second,
+		MaxBackoff:            time.Duration(d.Get("retry_wait_max").(int)) * time.Second,
+		RetryIdempotentWrites: d.Get("retry_idempotent_writes").(bool),
+	}
+	if err := client.SetRetryPolicy(retryPolicy); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to set looker API retry policy",
+			Detail:   "Err: " + err.Error(),
+		})
+		return nil, diags
+	}
+	devClient.SetRetryPolicy(retryPolicy)
+
+	maxRequestsPerSecond := d.Get("max_requests_per_second").(float64)
+	if err := client.SetRateLimit(maxRequestsPerSecond); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to set looker API rate limit",
+			Detail:   "Err: " + err.Error(),
+		})
+		return nil, diags
+	}
+	devClient.SetRateLimit(maxRequestsPerSecond)
+
 	var config Config
 
 	config.RequestCompletionCallback = func(req *http.Request, resp *http.Response) {
@@ -170,6 +401,7 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, p *schema.Pr
 		} else {
 			tflog.Debug(ctx, "HTTP Error", map[string]interface{}{"req_url": req.URL.String(), "req_method": req.Method, "resp_status": resp.Status, "resp_length": resp.ContentLength, "resp_headers": resp.Header})
 		}
+		warnOnLowRateLimit(ctx, resp)
 	}
 
 	client.OnRequestCompleted(config.RequestCompletionCallback)
@@ -250,7 +482,24 @@ func diagErrAppend(diags diag.Diagnostics, err error) diag.Diagnostics {
 	return diags
 }
 
+// lowRateLimitRatio is the share of the rate limit budget below which a warning is logged.
+const lowRateLimitRatio = 0.1
+
+// warnOnLowRateLimit logs a warning when the rate limit budget reported by Looker runs low, before throttling
+// starts failing requests. Responses without the remaining number of requests are ignored.
+func warnOnLowRateLimit(ctx context.Context, resp *http.Response) {
+	rate, ok := lookergo.ParseRate(resp.Header)
+	if !ok || float64(rate.Remaining) > float64(rate.Limit)*lowRateLimitRatio {
+		return
+	}
+	tflog.Warn(ctx, "Looker API rate limit almost exhausted", map[string]interface{}{
+		"limit": rate.Limit, "remaining": rate.Remaining, "reset": rate.Reset.String(),
+	})
+}
+
 func ensureDevClient(ctx context.Context, m interface{}) error {
+	m.(*Config).devClientMu.Lock()
+	defer m.(*Config).devClientMu.Unlock()
 	if m.(*Config).DevClient == nil {
 		tflog.Debug(ctx, fmt.Sprintf("Fn: %v, Action: create dev client connection", currFuncName()))
 		devClient, _, err := m.(*Config).Api.CreateDevConnection(ctx, func(req *http.Request, resp *http.Response) {
@@ -259,6 +508,7 @@ func ensureDevClient(ctx context.Context, m interface{}) error {
 			} else {
 				tflog.Debug(ctx, "DevClient: HTTP Error", map[string]interface{}{"req_url": req.URL.String(), "req_method": req.Method, "resp_status": resp.Status, "resp_length": resp.ContentLength, "resp_headers": resp.Header})
 			}
+			warnOnLowRateLimit(ctx, resp)
 		})
 		if err != nil {
 			return err
@@ -0,0 +1,185 @@
+package provider
+
+import (
+	"context"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestShutdown(t *testing.T) {
+	mux, config := setupMockServer(t)
+	mux.HandleFunc("/api/4.0/login/60", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"access_token":"t1","token_type":"Bearer","expires_in":3600}`)
+	})
+	mux.HandleFunc("/api/4.0/session", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"workspace_id":"dev"}`)
+	})
+	var logouts []string
+	mux.HandleFunc("/api/4.0/logout", func(w http.ResponseWriter, r *http.Request) {
+		logouts = append(logouts, r.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	config.DevClient.Workspace = "production"
+	if err := config.DevClient.EnsureStaticToken(context.Background(), config.Api, "60"); err != nil {
+		t.Fatalf("EnsureStaticToken returned error: %v", err)
+	}
+	if err := Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown returned error: %v", err)
+	}
+	if len(logouts) != 1 || logouts[0] != "Bearer t1" {
+		t.Errorf("logouts = %v, expected the dev session to be logged out", logouts)
+	}
+}
+
+func TestEnsureDevClient_Concurrent(t *testing.T) {
+	mux, config := setupMockServer(t)
+	var mu sync.Mutex
+	logins := 0
+	mux.HandleFunc("/api/4.0/user", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":"60"}`)
+	})
+	mux.HandleFunc("/api/4.0/login/60", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		logins++
+		mu.Unlock()
+		fmt.Fprint(w, `{"access_token":"t1","token_type":"Bearer","expires_in":3600}`)
+	})
+	mux.HandleFunc("/api/4.0/session", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"workspace_id":"dev"}`)
+	})
+
+	config.DevClient = nil
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := ensureDevClient(context.Background(), config); err != nil {
+				t.Errorf("ensureDevClient returned error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// A single dev connection for all the operations
+	if logins != 1 || config.DevClient == nil {
+		t.Errorf("logins = %d, DevClient set = %v, expected a single dev client", logins, config.DevClient != nil)
+	}
+}
+
+// setupTLSInstance serves an instance over TLS, whose API accepts the token t1, obtained with client_id abc.
+func setupTLSInstance(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/4.0/login", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("client_id") != "abc" || r.FormValue("client_secret") != "s3cr3t" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"access_token":"t1","token_type":"Bearer","expires_in":3600}`)
+	})
+	mux.HandleFunc("/api/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer t1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/api/4.0/session":
+			fmt.Fprint(w, `{"workspace_id":"production"}`)
+		case "/api/4.0/user":
+			fmt.Fprint(w, `{"id":"60"}`)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	server := httptest.NewTLSServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestProviderConfigure_ConfigPath(t *testing.T) {
+	server := setupTLSInstance(t)
+	dir := t.TempDir()
+	caCertFile := filepath.Join(dir, "ca.pem")
+	if err := os.WriteFile(caCertFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	configPath := filepath.Join(dir, "looker.ini")
+	ini := "[Looker]\nbase_url=https://example.looker.com\n\n[Dev]\nbase_url=" + server.URL + "\nclient_id=abc\nclient_secret=s3cr3t\n"
+	if err := os.WriteFile(configPath, []byte(ini), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	p := New("test")()
+	d := schema.TestResourceDataRaw(t, p.Schema, map[string]interface{}{
+		"config_path":    configPath,
+		"config_section": "Dev",
+		"ca_cert_file":   caCertFile,
+	})
+	m, diags := providerConfigure(context.Background(), d, p, "test")
+	if diags.HasError() {
+		t.Fatalf("configure returned %v", diags)
+	}
+	if id := m.(*Config).ApiUserID; id != "60" {
+		t.Errorf("ApiUserID = %q, expected 60", id)
+	}
+}
+
+func TestProviderConfigure_SDKEnv(t *testing.T) {
+	server := setupTLSInstance(t)
+	configPath := filepath.Join(t.TempDir(), "looker.ini")
+	ini := "[Looker]\nbase_url=https://example.looker.com\nclient_id=abc\nclient_secret=wrong\ntimeout=5\n"
+	if err := os.WriteFile(configPath, []byte(ini), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	// The environment variables of the SDKs override the ini file
+	t.Setenv("LOOKERSDK_BASE_URL", server.URL)
+	t.Setenv("LOOKERSDK_CLIENT_SECRET", "s3cr3t")
+	t.Setenv("LOOKERSDK_VERIFY_SSL", "false")
+
+	p := New("test")()
+	d := schema.TestResourceDataRaw(t, p.Schema, map[string]interface{}{
+		"config_path": configPath,
+	})
+	if _, diags := providerConfigure(context.Background(), d, p, "test"); diags.HasError() {
+		t.Fatalf("configure returned %v", diags)
+	}
+
+	t.Setenv("LOOKERSDK_TIMEOUT", "soon")
+	if _, diags := providerConfigure(context.Background(), d, p, "test"); !diags.HasError() {
+		t.Error("configure expected an error for an invalid LOOKERSDK_TIMEOUT")
+	}
+}
+
+func TestProviderConfigure_AccessToken(t *testing.T) {
+	server := setupTLSInstance(t)
+
+	p := New("test")()
+	for _, tc := range []struct {
+		verifySSL bool
+		ok        bool
+	}{
+		{true, false},
+		{false, true},
+	} {
+		d := schema.TestResourceDataRaw(t, p.Schema, map[string]interface{}{
+			"base_url":     server.URL,
+			"access_token": "t1",
+			"verify_ssl":   tc.verifySSL,
+		})
+		_, diags := providerConfigure(context.Background(), d, p, "test")
+		if diags.HasError() == tc.ok {
+			t.Errorf("configure with verify_ssl = %v returned %v", tc.verifySSL, diags)
+		}
+	}
+}
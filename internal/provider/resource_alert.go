@@ -6,6 +6,7 @@ import (
 	"github.com/devoteamgcloud/terraform-provider-looker/pkg/lookergo"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"strconv"
 	"strings"
 )
 
@@ -29,9 +30,10 @@ func resourceAlerts() *schema.Resource {
 				Description: "An optional description for the alert. This supplements the title",
 			},
 			"cron": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Vixie-Style crontab specification when to run. At minumum, it has to be longer than 15 minute intervals",
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Vixie-Style crontab specification when to run. At minumum, it has to be longer than 15 minute intervals",
+				ValidateFunc: validateCrontab,
 			},
 			"dashboard_element_id": {
 				Type:        schema.TypeString,
@@ -205,7 +207,9 @@ func resourceAlerts() *schema.Resource {
 			},
 		},
 
-		Importer: nil,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 	}
 }
 
@@ -231,7 +235,7 @@ func resourceAlertsCreate(ctx context.Context, d *schema.ResourceData, m interfa
 			obj := raw.(map[string]interface{})
 			filter := lookergo.AlertAppliedDashboardFilter{}
 			filter.FilterTitle = obj["filter_title"].(string)
-			filter.FilterDescription = obj["filter_description"].(*string)
+			filter.FilterDescription = castToPtr(obj["filter_description"].(string))
 			filter.FilterValue = obj["filter_value"].(string)
 			filter.FieldName = obj["field_name"].(string)
 			filters = append(filters, filter)
@@ -355,6 +359,10 @@ func resourceAlertsRead(ctx context.Context, d *schema.ResourceData, m interface
 	c := m.(*Config).Api // .(*lookergo.Client)
 	ID := d.Id()
 	alert, _, err := c.Alerts.Get(ctx, ID)
+	if lookergo.IsNotFound(err) {
+		d.SetId("") // Mark as deleted
+		return diags
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -362,13 +370,16 @@ func resourceAlertsRead(ctx context.Context, d *schema.ResourceData, m interface
 	d.Set("cron", alert.Cron)
 	d.Set("dashboard_element_id", alert.DashboardElementId)
 	flattenAppliedDashboard := func(alertApp *[]lookergo.AlertAppliedDashboardFilter) []interface{} {
+		if alertApp == nil {
+			return nil
+		}
 		appDashboards := make([]interface{}, len(*alertApp))
 		for i, elem := range *alertApp {
 			dashboard := make(map[string]interface{})
 			dashboard["filter_title"] = elem.FilterTitle
 			dashboard["field_name"] = elem.FieldName
 			dashboard["filter_value"] = elem.FilterValue
-			dashboard["filter_description"] = *elem.FilterDescription
+			dashboard["filter_description"] = valueFromPtr(elem.FilterDescription)
 			appDashboards[i] = dashboard
 		}
 		return appDashboards
@@ -404,8 +415,11 @@ func resourceAlertsRead(ctx context.Context, d *schema.ResourceData, m interface
 		dashboard := make(map[string]interface{})
 		dashboard["title"] = field.Title
 		dashboard["name"] = field.Name
-		filters := make([]interface{}, len(*field.Filter))
-		for i, elem := range *field.Filter {
+		var filters []interface{}
+		if field.Filter != nil {
+			filters = make([]interface{}, len(*field.Filter))
+		}
+		for i, elem := range valueFromPtr(field.Filter) {
 			filter := make(map[string]interface{})
 			filter["field_name"] = elem.FieldName
 			filter["field_value"] = elem.FieldValue
@@ -428,7 +442,9 @@ func resourceAlertsRead(ctx context.Context, d *schema.ResourceData, m interface
 	d.Set("followable", alert.Followable)
 	d.Set("is_disabled", alert.IsDisabled)
 	d.Set("is_public", alert.IsPublic)
+	d.Set("owner_id", alert.OwnerId)
 	d.Set("owner_display_name", alert.OwnerDisplayName)
+	d.Set("treshold", alert.Threshold)
 	return diags
 }
 
@@ -454,7 +470,7 @@ func resourceAlertsUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 			obj := raw.(map[string]interface{})
 			filter := lookergo.AlertAppliedDashboardFilter{}
 			filter.FilterTitle = obj["filter_title"].(string)
-			filter.FilterDescription = obj["filter_description"].(*string)
+			filter.FilterDescription = castToPtr(obj["filter_description"].(string))
 			filter.FilterValue = obj["filter_value"].(string)
 			filter.FieldName = obj["field_name"].(string)
 			filters = append(filters, filter)
@@ -579,3 +595,62 @@ func resourceAlertsDelete(ctx context.Context, d *schema.ResourceData, m interfa
 	d.SetId("")
 	return diags
 }
+
+// crontabFields describes the five fields of a Vixie-Style crontab specification, in order.
+var crontabFields = []struct {
+	name     string
+	min, max int
+	names    []string // Names accepted instead of numbers, starting at min.
+}{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}},
+	{name: "day of week", min: 0, max: 7, names: []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}},
+}
+
+// validateCrontab validates a Vixie-Style crontab specification such as "*/30 8-18 * * mon-fri", as used by alerts and
+// scheduled plans.
+func validateCrontab(val any, key string) (warns []string, errs []error) {
+	fields := strings.Fields(val.(string))
+	if len(fields) != len(crontabFields) {
+		errs = append(errs, fmt.Errorf("%s must have %d space separated fields (minute hour day-of-month month day-of-week), got %d", key, len(crontabFields), len(fields)))
+		return warns, errs
+	}
+
+	for i, field := range fields {
+		spec := crontabFields[i]
+		value := func(v string) (int, bool) {
+			for j, name := range spec.names {
+				if strings.EqualFold(v, name) {
+					return spec.min + j, true
+				}
+			}
+			n, err := strconv.Atoi(v)
+			return n, err == nil && n >= spec.min && n <= spec.max
+		}
+
+		for _, item := range strings.Split(field, ",") {
+			rng, step, hasStep := strings.Cut(item, "/")
+			if hasStep {
+				if n, err := strconv.Atoi(step); err != nil || n < 1 {
+					errs = append(errs, fmt.Errorf("%s: invalid step %q in %s field %q", key, step, spec.name, field))
+					continue
+				}
+			}
+			if rng == "*" {
+				continue
+			}
+			from, to, isRange := strings.Cut(rng, "-")
+			if !isRange {
+				to = from
+			}
+			start, okStart := value(from)
+			end, okEnd := value(to)
+			if !okStart || !okEnd || start > end {
+				errs = append(errs, fmt.Errorf("%s: invalid %s field %q, values must be between %d and %d", key, spec.name, field, spec.min, spec.max))
+			}
+		}
+	}
+	return warns, errs
+}
@@ -0,0 +1,226 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// setupMockAuthConfig serves an authentication configuration at path, which PATCH requests update. Group mappings
+// are returned in reverse order, with ids and Looker groups assigned.
+func setupMockAuthConfig(t *testing.T, path string) (*http.ServeMux, *Config, map[string]interface{}) {
+	mux, config := setupMockServer(t)
+	stored := map[string]interface{}{}
+	mux.HandleFunc("/api/"+path, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPatch:
+			var patch map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+				t.Fatal(err)
+			}
+			for key, value := range patch {
+				stored[key] = value
+			}
+			if mappings, ok := patch["groups_with_role_ids"].([]interface{}); ok {
+				reversed := make([]interface{}, len(mappings))
+				for i, raw := range mappings {
+					mapping := raw.(map[string]interface{})
+					mapping["id"] = fmt.Sprint(i + 1)
+					if mapping["looker_group_id"] == nil {
+						mapping["looker_group_id"] = fmt.Sprint(100 + i)
+					}
+					reversed[len(mappings)-1-i] = mapping
+				}
+				stored["groups_with_role_ids"] = reversed
+			}
+		default:
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+		}
+		json.NewEncoder(w).Encode(stored)
+	})
+	return mux, config, stored
+}
+
+func hasAttributeError(diags diag.Diagnostics, attribute string) bool {
+	for _, d := range diags {
+		if d.Severity == diag.Error && d.AttributePath.Equals(cty.GetAttrPath(attribute)) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestResourceSamlConfig(t *testing.T) {
+	_, config, stored := setupMockAuthConfig(t, "4.0/saml_config")
+	ctx := context.Background()
+	resource := resourceSamlConfig()
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"idp_url":                       "https://idp.example.com/sso",
+		"idp_issuer":                    "https://idp.example.com",
+		"idp_cert":                      "MIIC",
+		"user_attribute_map_email":      "email",
+		"user_attribute_map_first_name": "first_name",
+		"user_attribute_map_last_name":  "last_name",
+		"new_user_migration_types":      []interface{}{"google", "email"},
+		"group_mapping": []interface{}{
+			map[string]interface{}{"name": "Engineering", "role_ids": []interface{}{"2"}},
+			map[string]interface{}{"name": "Sales", "looker_group_id": "5", "role_ids": []interface{}{"3"}},
+		},
+	})
+	if diags := resource.CreateContext(ctx, d, config); diags.HasError() {
+		t.Fatalf("create returned error: %v", diags)
+	}
+	if d.Id() != samlConfigId {
+		t.Errorf("id = %q, expected %s", d.Id(), samlConfigId)
+	}
+	if stored["enabled"] != true || stored["new_user_migration_types"] != "email,google" {
+		t.Errorf("unexpected config sent: %v", stored)
+	}
+	for i, want := range []map[string]string{{"name": "Engineering", "looker_group_id": "100"}, {"name": "Sales", "looker_group_id": "5"}} {
+		for key, value := range want {
+			if got := d.Get(fmt.Sprintf("group_mapping.%d.%s", i, key)); got != value {
+				t.Errorf("group_mapping.%d.%s = %v, expected %s", i, key, got, value)
+			}
+		}
+	}
+
+	imported := importAndRead(t, resource, samlConfigId, config)
+	if imported.Get("idp_url") != "https://idp.example.com/sso" {
+		t.Errorf("imported idp_url = %v, expected https://idp.example.com/sso", imported.Get("idp_url"))
+	}
+
+	if diags := resource.DeleteContext(ctx, d, config); diags.HasError() {
+		t.Fatalf("delete returned error: %v", diags)
+	}
+	if stored["enabled"] != false || stored["idp_url"] != "https://idp.example.com/sso" {
+		t.Errorf("expected only enabled to be unset on delete, got %v", stored)
+	}
+}
+
+func TestResourceSamlConfig_TestConfig(t *testing.T) {
+	mux, config, stored := setupMockAuthConfig(t, "4.0/saml_config")
+	mux.HandleFunc("/api/4.0/saml_test_configs", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"message":"Validation Failed","errors":[{"field":"idp_cert","code":"invalid","message":"is not a valid certificate"}]}`)
+	})
+	resource := resourceSamlConfig()
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"idp_url":                       "https://idp.example.com/sso",
+		"idp_issuer":                    "https://idp.example.com",
+		"idp_cert":                      "not a certificate",
+		"user_attribute_map_email":      "email",
+		"user_attribute_map_first_name": "first_name",
+		"user_attribute_map_last_name":  "last_name",
+		"test_config":                   true,
+	})
+	diags := resource.CreateContext(context.Background(), d, config)
+	if !hasAttributeError(diags, "idp_cert") {
+		t.Errorf("expected an error on idp_cert, got %v", diags)
+	}
+	if len(stored) != 0 {
+		t.Errorf("expected the config not to be applied, got %v", stored)
+	}
+}
+
+func TestResourceLDAPConfig_TestConfig(t *testing.T) {
+	mux, config, stored := setupMockAuthConfig(t, "4.0/ldap_config")
+	var tests []string
+	for test, result := range map[string]string{
+		"test_connection": `{"status":"success","message":"Connected","issues":[{"severity":"warning","message":"TLS is not used"}]}`,
+		"test_auth":       `{"status":"error","message":"Cannot bind","details":"Invalid credentials"}`,
+		"test_user_info":  `{"status":"success"}`,
+	} {
+		test, result := test, result
+		mux.HandleFunc("/api/4.0/ldap_config/"+test, func(w http.ResponseWriter, r *http.Request) {
+			var body map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatal(err)
+			}
+			if body["connection_host"] != "ldap.example.com" || body["auth_password"] != "secret" {
+				t.Errorf("%s: unexpected config tested: %v", test, body)
+			}
+			tests = append(tests, test)
+			fmt.Fprint(w, result)
+		})
+	}
+	resource := resourceLDAPConfig()
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"connection_host":               "ldap.example.com",
+		"connection_port":               "389",
+		"auth_username":                 "cn=looker,dc=example,dc=com",
+		"auth_password":                 "secret",
+		"user_bind_base_dn":             "ou=users,dc=example,dc=com",
+		"user_id_attribute_names":       "uid",
+		"user_attribute_map_email":      "mail",
+		"user_attribute_map_first_name": "givenName",
+		"user_attribute_map_last_name":  "sn",
+		"user_attribute_map_ldap_id":    "uid",
+		"test_ldap_user":                "jane",
+		"test_config":                   true,
+	})
+	diags := resource.CreateContext(context.Background(), d, config)
+
+	if want := []string{"test_connection", "test_auth"}; !reflect.DeepEqual(tests, want) {
+		t.Errorf("tests run = %v, expected %v", tests, want)
+	}
+	var severities []diag.Severity
+	var summaries []string
+	for _, d := range diags {
+		severities = append(severities, d.Severity)
+		summaries = append(summaries, d.Summary)
+	}
+	if want := []string{"LDAP connection test: TLS is not used", "LDAP auth test failed: Cannot bind"}; !reflect.DeepEqual(summaries, want) {
+		t.Errorf("diagnostics = %v, expected %v", summaries, want)
+	}
+	if want := []diag.Severity{diag.Warning, diag.Error}; !reflect.DeepEqual(severities, want) {
+		t.Errorf("severities = %v, expected %v", severities, want)
+	}
+	if len(stored) != 0 {
+		t.Errorf("expected the config not to be applied, got %v", stored)
+	}
+}
+
+func TestResourceLDAPConfig(t *testing.T) {
+	_, config, stored := setupMockAuthConfig(t, "4.0/ldap_config")
+	resource := resourceLDAPConfig()
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"connection_host":               "ldap.example.com",
+		"connection_port":               "389",
+		"auth_password":                 "secret",
+		"user_bind_base_dn":             "ou=users,dc=example,dc=com",
+		"user_id_attribute_names":       "uid",
+		"user_attribute_map_email":      "mail",
+		"user_attribute_map_first_name": "givenName",
+		"user_attribute_map_last_name":  "sn",
+		"user_attribute_map_ldap_id":    "uid",
+		"test_ldap_user":                "jane",
+		"user_attribute_mapping": []interface{}{
+			map[string]interface{}{"name": "department", "required": true, "user_attribute_ids": []interface{}{"12"}},
+		},
+	})
+	if diags := resource.CreateContext(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("create returned error: %v", diags)
+	}
+	if _, ok := stored["test_ldap_user"]; ok {
+		t.Errorf("expected the test user not to be applied, got %v", stored)
+	}
+	if stored["auth_password"] != "secret" {
+		t.Errorf("auth_password = %v, expected secret", stored["auth_password"])
+	}
+	if got := d.Get("user_attribute_mapping").(*schema.Set).Len(); got != 1 {
+		t.Errorf("user_attribute_mapping has %d elements, expected 1", got)
+	}
+}
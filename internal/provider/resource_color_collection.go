@@ -269,6 +269,10 @@ func resourceColorCollectionRead(ctx context.Context, d *schema.ResourceData, m
 	cocoID := d.Id()
 
 	coco, _, err := c.ColorCollection.Get(ctx, cocoID)
+	if lookergo.IsNotFound(err) {
+		d.SetId("") // Mark as deleted
+		return diags
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -100,6 +100,11 @@ func resourceConnection() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 			},
+			"after_connect_statements": {
+				Description: "SQL statements (semicolon separated) to issue after connecting to the database. Requires `custom_after_connect_statements` license feature",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
 			"tunnel_id": {
 				Description: "The Id of the ssh tunnel this connection uses",
 				Type:        schema.TypeString,
@@ -242,7 +247,9 @@ func resourceConnection() *schema.Resource {
 				},
 			},
 		},
-		Importer: nil,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 	}
 }
 
@@ -393,14 +400,20 @@ func resourceConnectionRead(ctx context.Context, d *schema.ResourceData, m inter
 	c := m.(*Config).Api // .(*lookergo.Client)
 	tflog.Trace(ctx, fmt.Sprintf("Fn: %v, Action: start", currFuncName()))
 
-	connectionName := d.Get("name").(string)
-	connection, _, err := c.Connections.Get(ctx, connectionName)
+	connection, _, err := c.Connections.Get(ctx, d.Id())
+	if lookergo.IsNotFound(err) {
+		d.SetId("") // Mark as deleted
+		return diags
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	if d.Set("name", connection.Name) != nil {
 		return diag.FromErr(err)
 	}
+	if d.Set("username", connection.Username) != nil {
+		return diag.FromErr(err)
+	}
 	if d.Set("host", connection.Host) != nil {
 		return diag.FromErr(err)
 	}
@@ -0,0 +1,604 @@
+package provider
+
+import (
+	"context"
+	"github.com/devoteamgcloud/terraform-provider-looker/pkg/lookergo"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDashboard() *schema.Resource {
+	return &schema.Resource{
+		Description: `Manages a user defined dashboard.
+
+The dashboard is either described by its LookML serialization in ` + "`lookml`" + `, as exported by Looker, or by ` + "`element`" + ` and ` + "`filter`" + ` blocks.
+`,
+		CreateContext: resourceDashboardCreate,
+		ReadContext:   resourceDashboardRead,
+		UpdateContext: resourceDashboardUpdate,
+		DeleteContext: resourceDashboardDelete,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the dashboard",
+			},
+			"title": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"title", "lookml"},
+				ValidateFunc: validation.StringLenBetween(1, 255),
+				Description:  "Dashboard title. Defaults to the title in `lookml` when it is set.",
+			},
+			"folder_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the folder the dashboard is in. Changing it moves the dashboard.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Description. Defaults to the description in `lookml` when it is set.",
+			},
+			"hidden": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Is hidden. Defaults to false, or to the value in `lookml` when it is set.",
+			},
+			"query_timezone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Timezone in which the dashboard will run by default.",
+			},
+			"refresh_interval": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `Refresh interval, as a time duration phrase like "2 hours 30 minutes". A number with no time units will be interpreted as whole seconds.`,
+			},
+			"preferred_viewer": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"dashboards", "dashboards-next"}, false),
+				Description:  `The preferred route for viewing this dashboard. Valid values are: "dashboards", "dashboards-next".`,
+			},
+			"crossfilter_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Enables crossfiltering in dashboards - only available in dashboards-next",
+			},
+			"filters_bar_collapsed": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Sets the default state of the filters bar to collapsed or open",
+			},
+			"lookml": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"element", "filter"},
+				Description:   "LookML (YAML) serialization of the dashboard, as exported by Looker. The dashboard is recreated when it changes.",
+			},
+			"element": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{"lookml"},
+				Description:   "Dashboard elements (tiles). Elements changed outside of Terraform are reported as drift.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the dashboard element",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "vis",
+							Description: `Type of the element, e.g. "vis" or "text".`,
+						},
+						"title": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Title of the element",
+						},
+						"title_hidden": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether the title is hidden",
+						},
+						"title_text": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Text tile title",
+						},
+						"subtitle_text": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Text tile subtitle text",
+						},
+						"body_text": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Text tile body text",
+						},
+						"note_text": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Note text",
+						},
+						"look_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "ID of the look shown in the element",
+						},
+						"query_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "ID of the query shown in the element",
+						},
+						"merge_result_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "ID of the merge result shown in the element",
+						},
+						"refresh_interval": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Refresh interval of the element",
+						},
+					},
+				},
+			},
+			"filter": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{"lookml"},
+				Description:   "Dashboard filters.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the dashboard filter",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the filter",
+						},
+						"title": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Title of the filter",
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"field_filter", "date_filter", "number_filter", "string_filter"}, false),
+							Description:  `Type of the filter. Valid values are: "field_filter", "date_filter", "number_filter", "string_filter".`,
+						},
+						"default_value": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Default value of the filter",
+						},
+						"model": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Model of the filter (required if type = field_filter)",
+						},
+						"explore": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Explore of the filter (required if type = field_filter)",
+						},
+						"dimension": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Dimension of the filter (required if type = field_filter)",
+						},
+						"allow_multiple_values": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Whether the filter allows multiple filter values",
+						},
+						"required": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether the filter requires a value to run the dashboard",
+						},
+						"listens_to_filters": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Names of the filters this filter listens to",
+						},
+					},
+				},
+			},
+			"slug": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Content metadata slug",
+			},
+			"content_metadata_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the content metadata",
+			},
+			"user_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the user who owns the dashboard",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func expandDashboard(d *schema.ResourceData) *lookergo.Dashboard {
+	dashboard := &lookergo.Dashboard{
+		Title: d.Get("title").(string),
+	}
+	// An emptied description is sent too, to clear it.
+	if value, ok := d.GetOk("description"); ok || d.HasChange("description") {
+		dashboard.Description = castToPtr(value.(string))
+	}
+	if value, ok := d.GetOkExists("hidden"); ok {
+		dashboard.Hidden = boolPtr(value.(bool))
+	}
+	if value, ok := d.GetOk("query_timezone"); ok {
+		dashboard.QueryTimezone = castToPtr(value.(string))
+	}
+	if value, ok := d.GetOk("refresh_interval"); ok {
+		dashboard.RefreshInterval = castToPtr(value.(string))
+	}
+	if value, ok := d.GetOk("preferred_viewer"); ok {
+		dashboard.PreferredViewer = castToPtr(value.(string))
+	}
+	if value, ok := d.GetOkExists("crossfilter_enabled"); ok {
+		dashboard.CrossfilterEnabled = boolPtr(value.(bool))
+	}
+	if value, ok := d.GetOkExists("filters_bar_collapsed"); ok {
+		dashboard.FiltersBarCollapsed = boolPtr(value.(bool))
+	}
+	return dashboard
+}
+
+func expandDashboardElement(raw map[string]interface{}) *lookergo.DashboardElement {
+	element := &lookergo.DashboardElement{
+		Type:            raw["type"].(string),
+		Title:           castToPtr(raw["title"].(string)),
+		TitleHidden:     boolPtr(raw["title_hidden"].(bool)),
+		TitleText:       castToPtr(raw["title_text"].(string)),
+		SubtitleText:    castToPtr(raw["subtitle_text"].(string)),
+		BodyText:        castToPtr(raw["body_text"].(string)),
+		NoteText:        castToPtr(raw["note_text"].(string)),
+		RefreshInterval: castToPtr(raw["refresh_interval"].(string)),
+	}
+	if value := raw["look_id"].(string); value != "" {
+		element.LookId = castToPtr(value)
+	}
+	if value := raw["query_id"].(string); value != "" {
+		element.QueryId = castToPtr(value)
+	}
+	if value := raw["merge_result_id"].(string); value != "" {
+		element.MergeResultId = castToPtr(value)
+	}
+	return element
+}
+
+func flattenDashboardElement(element lookergo.DashboardElement) map[string]interface{} {
+	return map[string]interface{}{
+		"id":               element.Id,
+		"type":             element.Type,
+		"title":            valueFromPtr(element.Title),
+		"title_hidden":     valueFromPtr(element.TitleHidden),
+		"title_text":       valueFromPtr(element.TitleText),
+		"subtitle_text":    valueFromPtr(element.SubtitleText),
+		"body_text":        valueFromPtr(element.BodyText),
+		"note_text":        valueFromPtr(element.NoteText),
+		"look_id":          valueFromPtr(element.LookId),
+		"query_id":         valueFromPtr(element.QueryId),
+		"merge_result_id":  valueFromPtr(element.MergeResultId),
+		"refresh_interval": valueFromPtr(element.RefreshInterval),
+	}
+}
+
+func expandDashboardFilter(raw map[string]interface{}) *lookergo.DashboardFilter {
+	filter := &lookergo.DashboardFilter{
+		Name:                raw["name"].(string),
+		Title:               raw["title"].(string),
+		Type:                raw["type"].(string),
+		DefaultValue:        castToPtr(raw["default_value"].(string)),
+		AllowMultipleValues: boolPtr(raw["allow_multiple_values"].(bool)),
+		Required:            boolPtr(raw["required"].(bool)),
+		ListensToFilters:    interfaceListToStringList(raw["listens_to_filters"].([]interface{})),
+	}
+	if value := raw["model"].(string); value != "" {
+		filter.Model = castToPtr(value)
+	}
+	if value := raw["explore"].(string); value != "" {
+		filter.Explore = castToPtr(value)
+	}
+	if value := raw["dimension"].(string); value != "" {
+		filter.Dimension = castToPtr(value)
+	}
+	return filter
+}
+
+func flattenDashboardFilter(filter lookergo.DashboardFilter) map[string]interface{} {
+	return map[string]interface{}{
+		"id":                    filter.Id,
+		"name":                  filter.Name,
+		"title":                 filter.Title,
+		"type":                  filter.Type,
+		"default_value":         valueFromPtr(filter.DefaultValue),
+		"model":                 valueFromPtr(filter.Model),
+		"explore":               valueFromPtr(filter.Explore),
+		"dimension":             valueFromPtr(filter.Dimension),
+		"allow_multiple_values": valueFromPtr(filter.AllowMultipleValues),
+		"required":              valueFromPtr(filter.Required),
+		"listens_to_filters":    filter.ListensToFilters,
+	}
+}
+
+// orderByPriorIds returns items in the order of the ids already known in state, followed by the items created
+// outside of Terraform. This keeps the plan focused on actual changes instead of reordering.
+func orderByPriorIds[T any](items []T, id func(T) string, prior []interface{}) []T {
+	byId := make(map[string]T, len(items))
+	for _, item := range items {
+		byId[id(item)] = item
+	}
+	ordered := make([]T, 0, len(items))
+	for _, raw := range prior {
+		priorId := raw.(map[string]interface{})["id"].(string)
+		if item, ok := byId[priorId]; ok {
+			ordered = append(ordered, item)
+			delete(byId, priorId)
+		}
+	}
+	for _, item := range items {
+		if _, ok := byId[id(item)]; ok {
+			ordered = append(ordered, item)
+		}
+	}
+	return ordered
+}
+
+// syncDashboardElements updates the elements of the dashboard so they match the configuration. Elements are matched
+// by position: existing ones are updated in place, extra configured ones are created and left-over ones are deleted.
+func syncDashboardElements(ctx context.Context, c *lookergo.Client, d *schema.ResourceData, dashboardId string) error {
+	o, n := d.GetChange("element")
+	oldElements, newElements := o.([]interface{}), n.([]interface{})
+
+	for i, raw := range newElements {
+		element := expandDashboardElement(raw.(map[string]interface{}))
+		element.DashboardId = dashboardId
+		if i < len(oldElements) {
+			elementId := oldElements[i].(map[string]interface{})["id"].(string)
+			tflog.Debug(ctx, "Updating dashboard element", map[string]interface{}{"dashboard_id": dashboardId, "id": elementId})
+			if _, _, err := c.Dashboards.UpdateElement(ctx, elementId, element); err != nil {
+				return err
+			}
+			continue
+		}
+		tflog.Debug(ctx, "Creating dashboard element", map[string]interface{}{"dashboard_id": dashboardId})
+		if _, _, err := c.Dashboards.CreateElement(ctx, element); err != nil {
+			return err
+		}
+	}
+	for i := len(newElements); i < len(oldElements); i++ {
+		elementId := oldElements[i].(map[string]interface{})["id"].(string)
+		tflog.Debug(ctx, "Deleting dashboard element", map[string]interface{}{"dashboard_id": dashboardId, "id": elementId})
+		if _, err := c.Dashboards.DeleteElement(ctx, elementId); err != nil && !lookergo.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// syncDashboardFilters does the same as syncDashboardElements for the filters of the dashboard.
+func syncDashboardFilters(ctx context.Context, c *lookergo.Client, d *schema.ResourceData, dashboardId string) error {
+	o, n := d.GetChange("filter")
+	oldFilters, newFilters := o.([]interface{}), n.([]interface{})
+
+	for i, raw := range newFilters {
+		filter := expandDashboardFilter(raw.(map[string]interface{}))
+		filter.DashboardId = dashboardId
+		if i < len(oldFilters) {
+			filterId := oldFilters[i].(map[string]interface{})["id"].(string)
+			tflog.Debug(ctx, "Updating dashboard filter", map[string]interface{}{"dashboard_id": dashboardId, "id": filterId})
+			if _, _, err := c.Dashboards.UpdateFilter(ctx, filterId, filter); err != nil {
+				return err
+			}
+			continue
+		}
+		tflog.Debug(ctx, "Creating dashboard filter", map[string]interface{}{"dashboard_id": dashboardId})
+		if _, _, err := c.Dashboards.CreateFilter(ctx, filter); err != nil {
+			return err
+		}
+	}
+	for i := len(newFilters); i < len(oldFilters); i++ {
+		filterId := oldFilters[i].(map[string]interface{})["id"].(string)
+		tflog.Debug(ctx, "Deleting dashboard filter", map[string]interface{}{"dashboard_id": dashboardId, "id": filterId})
+		if _, err := c.Dashboards.DeleteFilter(ctx, filterId); err != nil && !lookergo.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func resourceDashboardCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+	folderId := d.Get("folder_id").(string)
+
+	if lookml, ok := d.GetOk("lookml"); ok {
+		tflog.Info(ctx, "Importing Looker dashboard from LookML", map[string]interface{}{"folder_id": folderId})
+		newDashboard, _, err := c.Dashboards.ImportFromLookml(ctx, &lookergo.DashboardLookml{FolderId: folderId, Lookml: lookml.(string)})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.SetId(newDashboard.Id)
+
+		// Apply the attributes which are set explicitly on top of the ones from the LookML.
+		dashboard := expandDashboard(d)
+		if _, ok := d.GetOk("title"); !ok {
+			dashboard.Title = newDashboard.Title
+		}
+		if _, _, err = c.Dashboards.Update(ctx, newDashboard.Id, dashboard); err != nil {
+			return diag.FromErr(err)
+		}
+		return resourceDashboardRead(ctx, d, m)
+	}
+
+	tflog.Info(ctx, "Creating Looker dashboard", map[string]interface{}{"folder_id": folderId})
+	dashboard := expandDashboard(d)
+	dashboard.FolderId = folderId
+	newDashboard, _, err := c.Dashboards.Create(ctx, dashboard)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(newDashboard.Id)
+
+	if err = syncDashboardElements(ctx, c, d, newDashboard.Id); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = syncDashboardFilters(ctx, c, d, newDashboard.Id); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, "Created Looker dashboard", map[string]interface{}{"id": newDashboard.Id, "title": newDashboard.Title})
+
+	return resourceDashboardRead(ctx, d, m)
+}
+
+func resourceDashboardRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+	dashboardId := d.Id()
+
+	dashboard, _, err := c.Dashboards.Get(ctx, dashboardId)
+	if lookergo.IsNotFound(err) {
+		d.SetId("") // Mark as deleted
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if dashboard.Deleted != nil && *dashboard.Deleted {
+		// Soft deleted dashboards are in the trash and no longer managed.
+		d.SetId("")
+		return diags
+	}
+
+	folderId := dashboard.FolderId
+	if folderId == "" && dashboard.Folder != nil {
+		folderId = dashboard.Folder.Id
+	}
+
+	d.Set("title", dashboard.Title)
+	d.Set("folder_id", folderId)
+	d.Set("description", valueFromPtr(dashboard.Description))
+	d.Set("hidden", valueFromPtr(dashboard.Hidden))
+	d.Set("query_timezone", valueFromPtr(dashboard.QueryTimezone))
+	d.Set("refresh_interval", valueFromPtr(dashboard.RefreshInterval))
+	d.Set("preferred_viewer", valueFromPtr(dashboard.PreferredViewer))
+	d.Set("crossfilter_enabled", valueFromPtr(dashboard.CrossfilterEnabled))
+	d.Set("filters_bar_collapsed", valueFromPtr(dashboard.FiltersBarCollapsed))
+	d.Set("slug", dashboard.Slug)
+	d.Set("content_metadata_id", dashboard.ContentMetadataId)
+	d.Set("user_id", dashboard.UserId)
+
+	// The content of dashboards managed through LookML is only tracked through the lookml attribute.
+	if _, ok := d.GetOk("lookml"); ok {
+		return diags
+	}
+
+	elements, _, err := c.Dashboards.ListElements(ctx, dashboardId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	elements = orderByPriorIds(elements, func(e lookergo.DashboardElement) string { return e.Id }, d.Get("element").([]interface{}))
+	flatElements := make([]interface{}, len(elements))
+	for i, element := range elements {
+		flatElements[i] = flattenDashboardElement(element)
+	}
+	if err = d.Set("element", flatElements); err != nil {
+		return diag.FromErr(err)
+	}
+
+	filters, _, err := c.Dashboards.ListFilters(ctx, dashboardId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	filters = orderByPriorIds(filters, func(f lookergo.DashboardFilter) string { return f.Id }, d.Get("filter").([]interface{}))
+	flatFilters := make([]interface{}, len(filters))
+	for i, filter := range filters {
+		flatFilters[i] = flattenDashboardFilter(filter)
+	}
+	if err = d.Set("filter", flatFilters); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceDashboardUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+	dashboardId := d.Id()
+
+	if d.HasChange("folder_id") {
+		folderId := d.Get("folder_id").(string)
+		tflog.Info(ctx, "Moving Looker dashboard", map[string]interface{}{"id": dashboardId, "folder_id": folderId})
+		if _, _, err := c.Dashboards.Move(ctx, dashboardId, folderId); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChanges("title", "description", "hidden", "query_timezone", "refresh_interval", "preferred_viewer",
+		"crossfilter_enabled", "filters_bar_collapsed") {
+		if _, _, err := c.Dashboards.Update(ctx, dashboardId, expandDashboard(d)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("element") {
+		if err := syncDashboardElements(ctx, c, d, dashboardId); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if d.HasChange("filter") {
+		if err := syncDashboardFilters(ctx, c, d, dashboardId); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceDashboardRead(ctx, d, m)
+}
+
+func resourceDashboardDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+
+	if _, err := c.Dashboards.Delete(ctx, d.Id()); err != nil && !lookergo.IsNotFound(err) {
+		return diag.FromErr(err)
+	}
+	// Finally mark as deleted
+	d.SetId("")
+
+	return diags
+}
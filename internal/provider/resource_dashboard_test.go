@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceDashboard_LookmlKeepsUnsetAttributes(t *testing.T) {
+	mux, config := setupMockServer(t)
+	mux.HandleFunc("/api/4.0/dashboards/lookml", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":"7","title":"Sales"}`)
+	})
+	var update map[string]interface{}
+	mux.HandleFunc("/api/4.0/dashboards/7", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+				t.Fatal(err)
+			}
+		}
+		fmt.Fprint(w, `{"id":"7","title":"Sales","description":"From LookML","hidden":true,"folder_id":"3"}`)
+	})
+	mux.HandleFunc("/api/4.0/dashboards/7/dashboard_elements", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[]`)
+	})
+	mux.HandleFunc("/api/4.0/dashboards/7/dashboard_filters", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[]`)
+	})
+
+	resource := resourceDashboard()
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"folder_id":      "3",
+		"lookml":         "- dashboard: sales",
+		"query_timezone": "UTC",
+	})
+	if diags := resource.CreateContext(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("create returned %v", diags)
+	}
+
+	// Only the attributes set in the configuration are applied over the LookML
+	expected := map[string]interface{}{"title": "Sales", "query_timezone": "UTC"}
+	if !reflect.DeepEqual(update, expected) {
+		t.Errorf("update = %v, expected %v", update, expected)
+	}
+	if d.Get("description") != "From LookML" || d.Get("hidden") != true {
+		t.Errorf("description = %v, hidden = %v, expected the values of the LookML", d.Get("description"), d.Get("hidden"))
+	}
+}
@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/devoteamgcloud/terraform-provider-looker/pkg/lookergo"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDatagroupTrigger() *schema.Resource {
+	return &schema.Resource{
+		Description: `Triggers a datagroup, e.g. after an ETL load, which invalidates its cache entries and rebuilds the persistent derived tables using it.
+
+The datagroup is triggered when the resource is created and every time ` + "`triggers`" + ` changes. Destroying the resource does not change the datagroup.
+`,
+		CreateContext: resourceDatagroupTriggerCreate,
+		ReadContext:   resourceDatagroupTriggerRead,
+		UpdateContext: resourceDatagroupTriggerUpdate,
+		DeleteContext: resourceDatagroupTriggerDelete,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the datagroup",
+			},
+			"model_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the model containing the datagroup",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the datagroup",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Arbitrary values that trigger the datagroup again when they change, e.g. the ID of the ETL load",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"stale_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Only mark the cache entries and PDTs built before now as stale (sets `stale_before`), instead of triggering the datagroup",
+			},
+			"triggered_at": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "When the datagroup was last triggered, in seconds since the epoch",
+			},
+			"stale_before": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Cache entries and PDTs built before this time are stale, in seconds since the epoch",
+			},
+		},
+	}
+}
+
+func resourceDatagroupTriggerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+
+	modelName, name := d.Get("model_name").(string), d.Get("name").(string)
+	datagroups, _, err := c.Datagroups.List(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	for _, datagroup := range datagroups {
+		if datagroup.ModelName == modelName && datagroup.Name == name {
+			d.SetId(datagroup.Id)
+			break
+		}
+	}
+	if d.Id() == "" {
+		return diag.Errorf("Datagroup %s not found in model %s", name, modelName)
+	}
+	if diags = triggerDatagroup(ctx, c, d); diags.HasError() {
+		d.SetId("")
+		return diags
+	}
+
+	return resourceDatagroupTriggerRead(ctx, d, m)
+}
+
+func resourceDatagroupTriggerRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+
+	datagroup, _, err := c.Datagroups.Get(ctx, d.Id())
+	if lookergo.IsNotFound(err) {
+		d.SetId("") // Mark as deleted
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("model_name", datagroup.ModelName)
+	d.Set("name", datagroup.Name)
+	d.Set("triggered_at", datagroup.TriggeredAt)
+	d.Set("stale_before", datagroup.StaleBefore)
+
+	return diags
+}
+
+func resourceDatagroupTriggerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+
+	if d.HasChange("triggers") {
+		if diags = triggerDatagroup(ctx, c, d); diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceDatagroupTriggerRead(ctx, d, m)
+}
+
+func resourceDatagroupTriggerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	// A trigger can't be undone: only remove it from the state.
+	d.SetId("")
+
+	return diags
+}
+
+// triggerDatagroup triggers the datagroup of d, or only marks it stale if stale_only is set.
+func triggerDatagroup(ctx context.Context, c *lookergo.Client, d *schema.ResourceData) diag.Diagnostics {
+	update := lookergo.Datagroup{}
+	if d.Get("stale_only").(bool) {
+		update.StaleBefore = time.Now().Unix()
+	} else {
+		update.TriggeredAt = time.Now().Unix()
+	}
+	tflog.Info(ctx, "Triggering Looker Datagroup", map[string]interface{}{"id": d.Id(), "stale_only": d.Get("stale_only")})
+	if _, _, err := c.Datagroups.Update(ctx, d.Id(), &update); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceDatagroupTrigger(t *testing.T) {
+	mux, config := setupMockServer(t)
+	var updates []map[string]int64
+	mux.HandleFunc("/api/4.0/datagroups", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id":"1","model_name":"sales","name":"daily"},{"id":"3","model_name":"sales","name":"etl"}]`)
+	})
+	mux.HandleFunc("/api/4.0/datagroups/3", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			var update map[string]int64
+			if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+				t.Fatal(err)
+			}
+			updates = append(updates, update)
+		}
+		fmt.Fprint(w, `{"id":"3","model_name":"sales","name":"etl","triggered_at":1700000000}`)
+	})
+	ctx := context.Background()
+	resource := resourceDatagroupTrigger()
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"model_name": "sales",
+		"name":       "etl",
+		"triggers":   map[string]interface{}{"load_id": "42"},
+	})
+	if diags := resource.CreateContext(ctx, d, config); diags.HasError() {
+		t.Fatalf("create returned error: %v", diags)
+	}
+	if d.Id() != "3" || d.Get("triggered_at") != 1700000000 {
+		t.Errorf("id = %q, triggered_at = %v, expected 3 and 1700000000", d.Id(), d.Get("triggered_at"))
+	}
+
+	d = schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"model_name": "sales",
+		"name":       "etl",
+		"triggers":   map[string]interface{}{"load_id": "43"},
+		"stale_only": true,
+	})
+	d.SetId("3")
+	if diags := resource.UpdateContext(ctx, d, config); diags.HasError() {
+		t.Fatalf("update returned error: %v", diags)
+	}
+
+	if len(updates) != 2 || updates[0]["triggered_at"] == 0 || len(updates[0]) != 1 || updates[1]["stale_before"] == 0 || len(updates[1]) != 1 {
+		t.Errorf("updates = %v, expected triggered_at then stale_before to be set", updates)
+	}
+}
+
+func TestResourceDatagroupTrigger_NotFound(t *testing.T) {
+	mux, config := setupMockServer(t)
+	mux.HandleFunc("/api/4.0/datagroups", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[]`)
+	})
+	resource := resourceDatagroupTrigger()
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{"model_name": "sales", "name": "etl"})
+	if diags := resource.CreateContext(context.Background(), d, config); !diags.HasError() {
+		t.Errorf("expected an error for a missing datagroup")
+	}
+}
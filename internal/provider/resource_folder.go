@@ -33,6 +33,11 @@ func resourceFolder() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(1, 255),
 			},
+			"content_metadata_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the content metadata of the folder, used to manage its access with `looker_folder_access`",
+			},
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -68,8 +73,8 @@ func resourceFolderCreate(ctx context.Context, d *schema.ResourceData, m interfa
 func resourceFolderRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
 	c := m.(*Config).Api // .(*lookergo.Client)
 	FolderID := d.Id()
-	Folder, response, err := c.Folders.Get(ctx, FolderID)
-	if response.StatusCode == 404 {
+	Folder, _, err := c.Folders.Get(ctx, FolderID)
+	if lookergo.IsNotFound(err) {
 		d.SetId("") // Mark as deleted
 		return diags
 	}
@@ -86,6 +91,9 @@ func resourceFolderRead(ctx context.Context, d *schema.ResourceData, m interface
 	if err = d.Set("parent_id", Folder.ParentId); err != nil {
 		return diag.FromErr(err)
 	}
+	if err = d.Set("content_metadata_id", Folder.ContentMetadataId); err != nil {
+		return diag.FromErr(err)
+	}
 
 	return diags
 }
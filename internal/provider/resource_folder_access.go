@@ -0,0 +1,263 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/devoteamgcloud/terraform-provider-looker/pkg/lookergo"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceFolderAccess() *schema.Resource {
+	return &schema.Resource{
+		Description: `Manages who can access a folder.
+
+This resource is authoritative: grants on the folder that are not declared in the ` + "`grant`" + ` blocks are removed.
+Destroying the resource removes all grants and makes the folder inherit its access from its parent folder again.
+`,
+		CreateContext: resourceFolderAccessCreate,
+		ReadContext:   resourceFolderAccessRead,
+		UpdateContext: resourceFolderAccessUpdate,
+		DeleteContext: resourceFolderAccessDelete,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the folder",
+			},
+			"folder_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the folder",
+			},
+			"inherits": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the folder inherits its access from its parent folder. No `grant` can be declared when true.",
+			},
+			"grant": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Access granted to a group or a user. Exactly one of `group_id` and `user_id` must be set.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "ID of the group",
+						},
+						"user_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "ID of the user",
+						},
+						"permission": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{lookergo.ContentMetadataPermission_VIEW, lookergo.ContentMetadataPermission_EDIT}, false),
+							Description:  `Permission granted. Valid values are: "view", "edit".`,
+						},
+					},
+				},
+			},
+			"content_metadata_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the content metadata of the folder",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				d.Set("folder_id", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+	}
+}
+
+// contentAccessKey identifies the group or user of a grant, e.g. "group:5" or "user:9".
+func contentAccessKey(groupId, userId string) string {
+	if groupId != "" {
+		return "group:" + groupId
+	}
+	return "user:" + userId
+}
+
+func expandFolderAccessGrants(d *schema.ResourceData) (map[string]lookergo.ContentMetadataAccess, error) {
+	grants := map[string]lookergo.ContentMetadataAccess{}
+	for _, elem := range d.Get("grant").(*schema.Set).List() {
+		raw := elem.(map[string]interface{})
+		grant := lookergo.ContentMetadataAccess{
+			GroupId:        raw["group_id"].(string),
+			UserId:         raw["user_id"].(string),
+			PermissionType: raw["permission"].(string),
+		}
+		if (grant.GroupId == "") == (grant.UserId == "") {
+			return nil, fmt.Errorf("exactly one of group_id and user_id must be set in a grant")
+		}
+		key := contentAccessKey(grant.GroupId, grant.UserId)
+		if _, exists := grants[key]; exists {
+			return nil, fmt.Errorf("%s is granted access more than once", key)
+		}
+		grants[key] = grant
+	}
+	return grants, nil
+}
+
+// syncFolderAccess makes the inherits flag and the grants of the content metadata match the configuration.
+func syncFolderAccess(ctx context.Context, c *lookergo.Client, d *schema.ResourceData, contentMetadataId string) error {
+	inherits := d.Get("inherits").(bool)
+	desired, err := expandFolderAccessGrants(d)
+	if err != nil {
+		return err
+	}
+	if inherits && len(desired) > 0 {
+		return fmt.Errorf("grants can not be declared when inherits is true")
+	}
+
+	metadata, _, err := c.ContentMetadata.Get(ctx, contentMetadataId)
+	if err != nil {
+		return err
+	}
+	if valueFromPtr(metadata.Inherits) != inherits {
+		tflog.Info(ctx, "Updating Looker content metadata", map[string]interface{}{"id": contentMetadataId, "inherits": inherits})
+		if _, _, err = c.ContentMetadata.Update(ctx, contentMetadataId, &lookergo.ContentMetadata{Inherits: boolPtr(inherits)}); err != nil {
+			return err
+		}
+	}
+	if inherits {
+		return nil
+	}
+
+	current, _, err := c.ContentMetadata.ListAccess(ctx, contentMetadataId, nil)
+	if err != nil {
+		return err
+	}
+	for _, access := range current {
+		key := contentAccessKey(access.GroupId, access.UserId)
+		grant, wanted := desired[key]
+		switch {
+		case !wanted:
+			tflog.Debug(ctx, "Removing Looker content access", map[string]interface{}{"id": access.Id, "grantee": key})
+			if _, err = c.ContentMetadata.DeleteAccess(ctx, access.Id); err != nil && !lookergo.IsNotFound(err) {
+				return err
+			}
+		case grant.PermissionType != access.PermissionType:
+			tflog.Debug(ctx, "Updating Looker content access", map[string]interface{}{"id": access.Id, "grantee": key, "permission": grant.PermissionType})
+			access.PermissionType = grant.PermissionType
+			if _, _, err = c.ContentMetadata.UpdateAccess(ctx, access.Id, &access); err != nil {
+				return err
+			}
+		}
+		delete(desired, key)
+	}
+	for key, grant := range desired {
+		tflog.Debug(ctx, "Adding Looker content access", map[string]interface{}{"grantee": key, "permission": grant.PermissionType})
+		grant.ContentMetadataId = contentMetadataId
+		if _, _, err = c.ContentMetadata.CreateAccess(ctx, &grant); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func resourceFolderAccessCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+	folderId := d.Get("folder_id").(string)
+
+	folder, _, err := c.Folders.Get(ctx, folderId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, "Setting Looker folder access", map[string]interface{}{"folder_id": folderId})
+	if err = syncFolderAccess(ctx, c, d, folder.ContentMetadataId); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(folderId)
+
+	return resourceFolderAccessRead(ctx, d, m)
+}
+
+func resourceFolderAccessRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+
+	folder, _, err := c.Folders.Get(ctx, d.Id())
+	if lookergo.IsNotFound(err) {
+		d.SetId("") // Mark as deleted
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	metadata, _, err := c.ContentMetadata.Get(ctx, folder.ContentMetadataId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var grants []interface{}
+	if !valueFromPtr(metadata.Inherits) {
+		accesses, _, err := c.ContentMetadata.ListAccess(ctx, folder.ContentMetadataId, nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		for _, access := range accesses {
+			grants = append(grants, map[string]interface{}{
+				"group_id":   access.GroupId,
+				"user_id":    access.UserId,
+				"permission": access.PermissionType,
+			})
+		}
+	}
+
+	d.Set("folder_id", folder.Id)
+	d.Set("content_metadata_id", folder.ContentMetadataId)
+	d.Set("inherits", valueFromPtr(metadata.Inherits))
+	if err = d.Set("grant", grants); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceFolderAccessUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+
+	tflog.Info(ctx, "Updating Looker folder access", map[string]interface{}{"folder_id": d.Id()})
+	if err := syncFolderAccess(ctx, c, d, d.Get("content_metadata_id").(string)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceFolderAccessRead(ctx, d, m)
+}
+
+func resourceFolderAccessDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+	contentMetadataId := d.Get("content_metadata_id").(string)
+
+	accesses, _, err := c.ContentMetadata.ListAccess(ctx, contentMetadataId, nil)
+	if lookergo.IsNotFound(err) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	for _, access := range accesses {
+		if _, err = c.ContentMetadata.DeleteAccess(ctx, access.Id); err != nil && !lookergo.IsNotFound(err) {
+			return diag.FromErr(err)
+		}
+	}
+	if _, _, err = c.ContentMetadata.Update(ctx, contentMetadataId, &lookergo.ContentMetadata{Inherits: boolPtr(true)}); err != nil && !lookergo.IsNotFound(err) {
+		return diag.FromErr(err)
+	}
+	// Finally mark as deleted
+	d.SetId("")
+
+	return diags
+}
@@ -90,8 +90,8 @@ func resourceGroupRead(ctx context.Context, d *schema.ResourceData, m interface{
 	}
 	groupID := idAsInt(d.Id())
 
-	group, response, err := c.Groups.Get(ctx, groupID)
-	if response.StatusCode == 404 {
+	group, _, err := c.Groups.Get(ctx, groupID)
+	if lookergo.IsNotFound(err) {
 		d.SetId("") // Mark as deleted
 		return diags
 	}
@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devoteamgcloud/terraform-provider-looker/pkg/lookergo"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceGroupGroup() *schema.Resource {
+	return &schema.Resource{
+		Description: `Adds a single group as a member of another group. Other members of the group are left untouched.
+
+Use this resource when the members of a group are managed from several places. Do not combine it with an authoritative ` + "`looker_group_member`" + ` on the same group.
+`,
+		CreateContext: resourceGroupGroupCreate,
+		ReadContext:   resourceGroupGroupRead,
+		DeleteContext: resourceGroupGroupDelete,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the membership, in the format `group_id:member_group_id`",
+			},
+			"group_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the group",
+			},
+			"member_group_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the group added as a member",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				groupId, memberGroupId, err := parseTwoPartId(d.Id(), "group_id", "member_group_id")
+				if err != nil {
+					return nil, err
+				}
+				d.Set("group_id", groupId)
+				d.Set("member_group_id", memberGroupId)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+	}
+}
+
+func resourceGroupGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+
+	groupId, err := strconv.Atoi(d.Get("group_id").(string))
+	if err != nil {
+		return diag.Errorf("group_id must be a number: %v", err)
+	}
+	memberGroupId, err := strconv.Atoi(d.Get("member_group_id").(string))
+	if err != nil {
+		return diag.Errorf("member_group_id must be a number: %v", err)
+	}
+
+	tflog.Info(ctx, "Add group to group", map[string]interface{}{"group_id": groupId, "member_group_id": memberGroupId})
+	if _, _, err = c.Groups.AddMemberGroup(ctx, groupId, memberGroupId); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(buildTwoPartId(d.Get("group_id").(string), d.Get("member_group_id").(string)))
+
+	return resourceGroupGroupRead(ctx, d, m)
+}
+
+func resourceGroupGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+
+	groupId, err := strconv.Atoi(d.Get("group_id").(string))
+	if err != nil {
+		return diag.Errorf("group_id must be a number: %v", err)
+	}
+	memberGroups, _, err := c.Groups.ListMemberGroups(ctx, groupId, nil)
+	if lookergo.IsNotFound(err) {
+		d.SetId("") // Mark as deleted
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	memberGroupId := d.Get("member_group_id").(string)
+	for _, group := range memberGroups {
+		if idAsString(group.Id) == memberGroupId {
+			return diags
+		}
+	}
+	tflog.Info(ctx, "Group is no longer a member of the group", map[string]interface{}{"group_id": groupId, "member_group_id": memberGroupId})
+	d.SetId("") // Mark as deleted
+
+	return diags
+}
+
+func resourceGroupGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+
+	groupId, err := strconv.Atoi(d.Get("group_id").(string))
+	if err != nil {
+		return diag.Errorf("group_id must be a number: %v", err)
+	}
+	memberGroupId, err := strconv.Atoi(d.Get("member_group_id").(string))
+	if err != nil {
+		return diag.Errorf("member_group_id must be a number: %v", err)
+	}
+
+	tflog.Info(ctx, "Remove group from group", map[string]interface{}{"group_id": groupId, "member_group_id": memberGroupId})
+	if _, err = c.Groups.RemoveMemberGroup(ctx, groupId, memberGroupId); err != nil && !lookergo.IsNotFound(err) {
+		return diag.FromErr(err)
+	}
+	// Finally mark as deleted
+	d.SetId("")
+
+	return diags
+}
@@ -2,14 +2,28 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"github.com/devoteamgcloud/terraform-provider-looker/pkg/lookergo"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"strconv"
+)
+
+const (
+	groupMemberModeAuthoritative = "authoritative"
+	groupMemberModeAdditive      = "additive"
 )
 
 func resourceGroupMember() *schema.Resource {
 	return &schema.Resource{
+		Description: `Manages the members of a group.
+
+In ` + "`additive`" + ` mode, only the declared users and groups are managed and other members of the group are left untouched.
+In ` + "`authoritative`" + ` mode, members of the group that are not declared are removed.
+Do not use an authoritative ` + "`looker_group_member`" + ` together with ` + "`looker_group_user`" + ` or ` + "`looker_group_group`" + ` on the same group, they will fight over the membership.
+`,
 		CreateContext: resourceGroupMemberCreate,
 		ReadContext:   resourceGroupMemberRead,
 		UpdateContext: resourceGroupMemberUpdate,
@@ -21,6 +35,13 @@ func resourceGroupMember() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      groupMemberModeAdditive,
+				ValidateFunc: validation.StringInSlice([]string{groupMemberModeAuthoritative, groupMemberModeAdditive}, false),
+				Description:  `Either "additive" (default) or "authoritative". In authoritative mode, members that are not declared are removed from the group.`,
+			},
 			"user": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -58,8 +79,103 @@ func resourceGroupMember() *schema.Resource {
 				},
 			},
 		},
-		Importer: nil,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceGroupMemberImport,
+		},
+	}
+}
+
+// resourceGroupMemberImport imports all current members of the group with the given id.
+func resourceGroupMemberImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+
+	groupId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("invalid group id %q, expected a number", d.Id())
+	}
+	d.Set("target_group_id", d.Id())
+	d.Set("mode", groupMemberModeAdditive)
+
+	if err = setGroupMembers(ctx, c, d, groupId, true); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// setGroupMembers stores the members of the group in the state. Unless all is true, only members that are already in
+// the state are kept, so members managed elsewhere are ignored.
+func setGroupMembers(ctx context.Context, c *lookergo.Client, d *schema.ResourceData, groupId int, all bool) error {
+	if _, ok := d.GetOk("user"); ok || all {
+		declared := getSetIds(d, "user")
+		memberUsers, _, err := c.Groups.ListMemberUsers(ctx, groupId, nil)
+		if err != nil {
+			return err
+		}
+		var userItems []interface{}
+		for _, user := range memberUsers {
+			if all || contains(declared, idAsString(user.Id)) {
+				userItems = append(userItems, map[string]interface{}{"id": idAsString(user.Id), "first_name": user.FirstName, "last_name": user.LastName})
+			}
+		}
+		if err = d.Set("user", userItems); err != nil {
+			return err
+		}
+	}
+
+	if _, ok := d.GetOk("group"); ok || all {
+		declared := getSetIds(d, "group")
+		memberGroups, _, err := c.Groups.ListMemberGroups(ctx, groupId, nil)
+		if err != nil {
+			return err
+		}
+		var groupItems []interface{}
+		for _, group := range memberGroups {
+			if all || contains(declared, idAsString(group.Id)) {
+				groupItems = append(groupItems, map[string]interface{}{"id": idAsString(group.Id), "name": group.Name})
+			}
+		}
+		if err = d.Set("group", groupItems); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// removeUndeclaredGroupMembers removes the users and groups from the group that are not declared in the configuration.
+func removeUndeclaredGroupMembers(ctx context.Context, c *lookergo.Client, d *schema.ResourceData, groupId int) error {
+	declaredUsers := getSetIds(d, "user")
+	memberUsers, _, err := c.Groups.ListMemberUsers(ctx, groupId, nil)
+	if err != nil {
+		return err
+	}
+	for _, user := range memberUsers {
+		if contains(declaredUsers, idAsString(user.Id)) {
+			continue
+		}
+		tflog.Info(ctx, "Remove undeclared user from group", map[string]interface{}{"id": user.Id})
+		if _, err = c.Groups.RemoveMemberUser(ctx, groupId, idAsInt(user.Id)); err != nil && !lookergo.IsNotFound(err) {
+			return err
+		}
+	}
+
+	declaredGroups := getSetIds(d, "group")
+	memberGroups, _, err := c.Groups.ListMemberGroups(ctx, groupId, nil)
+	if err != nil {
+		return err
+	}
+	for _, group := range memberGroups {
+		if contains(declaredGroups, idAsString(group.Id)) {
+			continue
+		}
+		tflog.Info(ctx, "Remove undeclared group from group", map[string]interface{}{"id": group.Id})
+		if _, err = c.Groups.RemoveMemberGroup(ctx, groupId, group.Id); err != nil && !lookergo.IsNotFound(err) {
+			return err
+		}
 	}
+
+	return nil
 }
 
 func parentGroup(ctx context.Context, d *schema.ResourceData, c *lookergo.Client) (*lookergo.Group, error) {
@@ -114,7 +230,12 @@ func resourceGroupMemberCreate(ctx context.Context, d *schema.ResourceData, m in
 		}
 		d.Set("group", groupItems)
 	}
-	d.SetId("-")
+	if d.Get("mode").(string) == groupMemberModeAuthoritative {
+		if err = removeUndeclaredGroupMembers(ctx, c, d, pg.Id); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	d.SetId(idAsString(pg.Id))
 	return resourceGroupMemberRead(ctx, d, m)
 }
 
@@ -122,47 +243,20 @@ func resourceGroupMemberRead(ctx context.Context, d *schema.ResourceData, m inte
 	c := m.(*Config).Api // .(*lookergo.Client)
 
 	pg, err := parentGroup(ctx, d, c)
+	if lookergo.IsNotFound(err) {
+		d.SetId("") // Mark as deleted
+		return diags
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	tflog.Info(ctx, "Read group members for", map[string]interface{}{"target_group_id": pg.Id})
+	// Resources created by earlier versions of the provider have the placeholder id "-".
+	d.SetId(idAsString(pg.Id))
 
-	userSet, ok := d.GetOk("user")
-	if ok {
-		memberUsers, _, err := c.Groups.ListMemberUsers(ctx, pg.Id, nil)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-		var userItems []interface{}
-		for _, raw := range userSet.(*schema.Set).List() {
-			obj := raw.(map[string]interface{})
-
-			for _, user := range memberUsers {
-				if idAsString(user.Id) == obj["id"].(string) {
-					userItems = append(userItems, map[string]interface{}{"id": idAsString(user.Id), "first_name": user.FirstName, "last_name": user.LastName})
-				}
-			}
-		}
-		d.Set("user", userItems)
-	}
-
-	groupSet, ok := d.GetOk("group")
-	if ok {
-		memberGroups, _, err := c.Groups.ListMemberGroups(ctx, pg.Id, nil)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-		var groupItems []interface{}
-		for _, raw := range groupSet.(*schema.Set).List() {
-			obj := raw.(map[string]interface{})
-
-			for _, group := range memberGroups {
-				if idAsString(group.Id) == obj["id"].(string) {
-					groupItems = append(groupItems, map[string]interface{}{"id": idAsString(group.Id), "name": group.Name})
-				}
-			}
-		}
-		d.Set("group", groupItems)
+	// In authoritative mode all members are reported, so members added outside of Terraform show up as a diff.
+	if err = setGroupMembers(ctx, c, d, pg.Id, d.Get("mode").(string) == groupMemberModeAuthoritative); err != nil {
+		return diag.FromErr(err)
 	}
 
 	return diags
@@ -248,6 +342,12 @@ func resourceGroupMemberUpdate(ctx context.Context, d *schema.ResourceData, m in
 		}
 	}
 
+	if d.Get("mode").(string) == groupMemberModeAuthoritative {
+		if err = removeUndeclaredGroupMembers(ctx, c, d, pg.Id); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceGroupMemberRead(ctx, d, m)
 }
 
@@ -0,0 +1,233 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// mockGroup serves the members of group 5, which can be changed through the API like on a real instance.
+type mockGroup struct {
+	users  map[string]bool
+	groups map[string]bool
+}
+
+func setupMockGroup(t *testing.T, users, groups []string) (*mockGroup, *Config) {
+	g := &mockGroup{users: map[string]bool{}, groups: map[string]bool{}}
+	for _, id := range users {
+		g.users[id] = true
+	}
+	for _, id := range groups {
+		g.groups[id] = true
+	}
+
+	mux, config := setupMockServer(t)
+	mux.HandleFunc("/api/4.0/groups/5", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":"5","name":"Sales team"}`)
+	})
+	handleMembers := func(kind, idField string, members map[string]bool) {
+		listPath := "/api/4.0/groups/5/" + kind
+		mux.HandleFunc(listPath, func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet:
+				var items []string
+				for id := range members {
+					items = append(items, fmt.Sprintf(`{"id":"%s"}`, id))
+				}
+				fmt.Fprintf(w, "[%s]", strings.Join(items, ","))
+			case http.MethodPost:
+				body := map[string]int{}
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					t.Fatal(err)
+				}
+				id := fmt.Sprint(body[idField])
+				members[id] = true
+				fmt.Fprintf(w, `{"id":"%s"}`, id)
+			default:
+				t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			}
+		})
+		mux.HandleFunc(listPath+"/", func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodDelete {
+				t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			}
+			id := strings.TrimPrefix(r.URL.Path, listPath+"/")
+			if !members[id] {
+				http.Error(w, `{"message":"Not found"}`, http.StatusNotFound)
+				return
+			}
+			delete(members, id)
+			w.WriteHeader(http.StatusNoContent)
+		})
+	}
+	handleMembers("users", "user_id", g.users)
+	handleMembers("groups", "group_id", g.groups)
+
+	return g, config
+}
+
+func memberIds(members map[string]bool) []string {
+	ids := []string{}
+	for id := range members {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func userItems(ids ...string) []interface{} {
+	items := make([]interface{}, len(ids))
+	for i, id := range ids {
+		items[i] = map[string]interface{}{"id": id}
+	}
+	return items
+}
+
+func TestResourceGroupMember_ReadModes(t *testing.T) {
+	_, config := setupMockGroup(t, []string{"60", "61"}, []string{"7"})
+
+	for mode, want := range map[string][]string{
+		groupMemberModeAdditive:      {"60"},
+		groupMemberModeAuthoritative: {"60", "61"},
+	} {
+		d := schema.TestResourceDataRaw(t, resourceGroupMember().Schema, map[string]interface{}{
+			"target_group_id": "5",
+			"mode":            mode,
+			"user":            userItems("60"),
+		})
+		d.SetId("5")
+
+		if diags := resourceGroupMemberRead(context.Background(), d, config); diags.HasError() {
+			t.Fatalf("read returned error: %v", diags)
+		}
+		if got := setIds(d, "user"); !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: user ids = %v, expected %v", mode, got, want)
+		}
+		// Groups are reported in authoritative mode, even when none are declared.
+		if got, wantGroups := setIds(d, "group"), map[string][]string{groupMemberModeAuthoritative: {"7"}}[mode]; !reflect.DeepEqual(got, wantGroups) {
+			t.Errorf("%s: group ids = %v, expected %v", mode, got, wantGroups)
+		}
+	}
+}
+
+func TestResourceGroupMember_CreateAuthoritative(t *testing.T) {
+	g, config := setupMockGroup(t, []string{"60", "61"}, []string{"7"})
+
+	d := schema.TestResourceDataRaw(t, resourceGroupMember().Schema, map[string]interface{}{
+		"target_group_id": "5",
+		"mode":            groupMemberModeAuthoritative,
+		"user":            userItems("60", "62"),
+	})
+	if diags := resourceGroupMemberCreate(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("create returned error: %v", diags)
+	}
+
+	if got, want := memberIds(g.users), []string{"60", "62"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("users of the group = %v, expected %v", got, want)
+	}
+	if got := memberIds(g.groups); len(got) != 0 {
+		t.Errorf("groups of the group = %v, expected none", got)
+	}
+	if d.Id() != "5" {
+		t.Errorf("id = %q, expected 5", d.Id())
+	}
+}
+
+func TestResourceGroupMember_CreateAdditive(t *testing.T) {
+	g, config := setupMockGroup(t, []string{"61"}, []string{"7"})
+
+	d := schema.TestResourceDataRaw(t, resourceGroupMember().Schema, map[string]interface{}{
+		"target_group_id": "5",
+		"user":            userItems("60"),
+	})
+	if diags := resourceGroupMemberCreate(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("create returned error: %v", diags)
+	}
+
+	if got, want := memberIds(g.users), []string{"60", "61"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("users of the group = %v, expected %v", got, want)
+	}
+	if got, want := memberIds(g.groups), []string{"7"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("groups of the group = %v, expected %v", got, want)
+	}
+	if got, want := setIds(d, "user"), []string{"60"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("user ids = %v, expected %v", got, want)
+	}
+}
+
+func TestResourceGroupUser(t *testing.T) {
+	g, config := setupMockGroup(t, []string{"61"}, nil)
+	ctx := context.Background()
+	resource := resourceGroupUser()
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{"group_id": "5", "user_id": "60"})
+	if diags := resource.CreateContext(ctx, d, config); diags.HasError() {
+		t.Fatalf("create returned error: %v", diags)
+	}
+	if d.Id() != "5:60" {
+		t.Errorf("id = %q, expected 5:60", d.Id())
+	}
+	if got, want := memberIds(g.users), []string{"60", "61"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("users of the group = %v, expected %v", got, want)
+	}
+
+	imported := importAndRead(t, resource, "5:61", config)
+	if imported.Get("group_id") != "5" || imported.Get("user_id") != "61" {
+		t.Errorf("imported group_id = %v, user_id = %v, expected 5 and 61", imported.Get("group_id"), imported.Get("user_id"))
+	}
+
+	if diags := resource.DeleteContext(ctx, d, config); diags.HasError() {
+		t.Fatalf("delete returned error: %v", diags)
+	}
+	if got, want := memberIds(g.users), []string{"61"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("users of the group = %v, expected %v", got, want)
+	}
+
+	// The membership was removed outside of Terraform.
+	d = schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{"group_id": "5", "user_id": "60"})
+	d.SetId("5:60")
+	if diags := resource.ReadContext(ctx, d, config); diags.HasError() {
+		t.Fatalf("read returned error: %v", diags)
+	}
+	if d.Id() != "" {
+		t.Errorf("id = %q, expected the resource to be marked as deleted", d.Id())
+	}
+}
+
+func TestResourceGroupGroup(t *testing.T) {
+	g, config := setupMockGroup(t, nil, []string{"8"})
+	ctx := context.Background()
+	resource := resourceGroupGroup()
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{"group_id": "5", "member_group_id": "7"})
+	if diags := resource.CreateContext(ctx, d, config); diags.HasError() {
+		t.Fatalf("create returned error: %v", diags)
+	}
+	if d.Id() != "5:7" {
+		t.Errorf("id = %q, expected 5:7", d.Id())
+	}
+	if diags := resource.DeleteContext(ctx, d, config); diags.HasError() {
+		t.Fatalf("delete returned error: %v", diags)
+	}
+	if got, want := memberIds(g.groups), []string{"8"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("groups of the group = %v, expected %v", got, want)
+	}
+}
+
+func TestParseTwoPartId(t *testing.T) {
+	if first, second, err := parseTwoPartId("5:60", "group_id", "user_id"); err != nil || first != "5" || second != "60" {
+		t.Errorf("parseTwoPartId returned %q, %q, %v", first, second, err)
+	}
+	for _, id := range []string{"5", "5:", ":60", ""} {
+		if _, _, err := parseTwoPartId(id, "group_id", "user_id"); err == nil {
+			t.Errorf("parseTwoPartId(%q) expected an error", id)
+		}
+	}
+}
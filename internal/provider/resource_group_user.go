@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devoteamgcloud/terraform-provider-looker/pkg/lookergo"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceGroupUser() *schema.Resource {
+	return &schema.Resource{
+		Description: `Adds a single user to a group. Other members of the group are left untouched.
+
+Use this resource when the members of a group are managed from several places. Do not combine it with an authoritative ` + "`looker_group_member`" + ` on the same group.
+`,
+		CreateContext: resourceGroupUserCreate,
+		ReadContext:   resourceGroupUserRead,
+		DeleteContext: resourceGroupUserDelete,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the membership, in the format `group_id:user_id`",
+			},
+			"group_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the group",
+			},
+			"user_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the user added as a member",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				groupId, userId, err := parseTwoPartId(d.Id(), "group_id", "user_id")
+				if err != nil {
+					return nil, err
+				}
+				d.Set("group_id", groupId)
+				d.Set("user_id", userId)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+	}
+}
+
+func resourceGroupUserCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+
+	groupId, err := strconv.Atoi(d.Get("group_id").(string))
+	if err != nil {
+		return diag.Errorf("group_id must be a number: %v", err)
+	}
+	userId, err := strconv.Atoi(d.Get("user_id").(string))
+	if err != nil {
+		return diag.Errorf("user_id must be a number: %v", err)
+	}
+
+	tflog.Info(ctx, "Add user to group", map[string]interface{}{"group_id": groupId, "user_id": userId})
+	if _, _, err = c.Groups.AddMemberUser(ctx, groupId, userId); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(buildTwoPartId(d.Get("group_id").(string), d.Get("user_id").(string)))
+
+	return resourceGroupUserRead(ctx, d, m)
+}
+
+func resourceGroupUserRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+
+	groupId, err := strconv.Atoi(d.Get("group_id").(string))
+	if err != nil {
+		return diag.Errorf("group_id must be a number: %v", err)
+	}
+	memberUsers, _, err := c.Groups.ListMemberUsers(ctx, groupId, nil)
+	if lookergo.IsNotFound(err) {
+		d.SetId("") // Mark as deleted
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	userId := d.Get("user_id").(string)
+	for _, user := range memberUsers {
+		if user.Id == userId {
+			return diags
+		}
+	}
+	tflog.Info(ctx, "User is no longer a member of the group", map[string]interface{}{"group_id": groupId, "user_id": userId})
+	d.SetId("") // Mark as deleted
+
+	return diags
+}
+
+func resourceGroupUserDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+
+	groupId, err := strconv.Atoi(d.Get("group_id").(string))
+	if err != nil {
+		return diag.Errorf("group_id must be a number: %v", err)
+	}
+	userId, err := strconv.Atoi(d.Get("user_id").(string))
+	if err != nil {
+		return diag.Errorf("user_id must be a number: %v", err)
+	}
+
+	tflog.Info(ctx, "Remove user from group", map[string]interface{}{"group_id": groupId, "user_id": userId})
+	if _, err = c.Groups.RemoveMemberUser(ctx, groupId, userId); err != nil && !lookergo.IsNotFound(err) {
+		return diag.FromErr(err)
+	}
+	// Finally mark as deleted
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/devoteamgcloud/terraform-provider-looker/pkg/lookergo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// setupMockServer returns a provider config whose clients talk to a mock server, and the mux of that server.
+func setupMockServer(t *testing.T) (*http.ServeMux, *Config) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	newClient := func() *lookergo.Client {
+		client := lookergo.NewClient(nil)
+		if err := client.SetBaseURL(server.URL + "/api/"); err != nil {
+			t.Fatal(err)
+		}
+		return client
+	}
+	devClient := newClient()
+	devClient.Workspace = "dev"
+	return mux, &Config{Api: newClient(), DevClient: devClient}
+}
+
+// setupMockInstance returns a provider config whose clients talk to a mock server serving the given responses.
+func setupMockInstance(t *testing.T, responses map[string]string) *Config {
+	mux, config := setupMockServer(t)
+	for path, body := range responses {
+		body := body
+		mux.HandleFunc("/api/"+path, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet {
+				t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			}
+			fmt.Fprint(w, body)
+		})
+	}
+	return config
+}
+
+// importAndRead imports the resource with the given id, then refreshes it like terraform import does.
+func importAndRead(t *testing.T, resource *schema.Resource, id string, config *Config) *schema.ResourceData {
+	ctx := context.Background()
+	d := resource.TestResourceData()
+	d.SetId(id)
+
+	imported, err := resource.Importer.StateContext(ctx, d, config)
+	if err != nil {
+		t.Fatalf("import returned error: %v", err)
+	}
+	if len(imported) != 1 {
+		t.Fatalf("import returned %d resources, expected 1", len(imported))
+	}
+	d = imported[0]
+	if diags := resource.ReadContext(ctx, d, config); diags.HasError() {
+		t.Fatalf("read returned error: %v", diags)
+	}
+	if d.Id() != id {
+		t.Errorf("id = %q, expected %q", d.Id(), id)
+	}
+	return d
+}
+
+func setIds(d *schema.ResourceData, key string) []string {
+	ids := getSetIds(d, key)
+	sort.Strings(ids)
+	return ids
+}
+
+func TestResourceGroupMember_Import(t *testing.T) {
+	config := setupMockInstance(t, map[string]string{
+		"4.0/groups/5":        `{"id":"5","name":"Sales team"}`,
+		"4.0/groups/5/users":  `[{"id":"60","first_name":"Jane","last_name":"Doe"},{"id":"61","first_name":"John","last_name":"Doe"}]`,
+		"4.0/groups/5/groups": `[{"id":"7","name":"Sales EMEA"}]`,
+	})
+
+	d := importAndRead(t, resourceGroupMember(), "5", config)
+
+	if got := d.Get("target_group_id"); got != "5" {
+		t.Errorf("target_group_id = %v, expected 5", got)
+	}
+	if got, want := setIds(d, "user"), []string{"60", "61"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("user ids = %v, expected %v", got, want)
+	}
+	if got, want := setIds(d, "group"), []string{"7"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("group ids = %v, expected %v", got, want)
+	}
+	group := d.Get("group").(*schema.Set).List()[0].(map[string]interface{})
+	if group["name"] != "Sales EMEA" {
+		t.Errorf("group name = %v, expected Sales EMEA", group["name"])
+	}
+}
+
+func TestResourceGroupMember_ImportInvalidId(t *testing.T) {
+	config := setupMockInstance(t, nil)
+	d := resourceGroupMember().TestResourceData()
+	d.SetId("sales")
+
+	if _, err := resourceGroupMemberImport(context.Background(), d, config); err == nil {
+		t.Error("import expected an error for a non numeric id")
+	}
+}
+
+func TestResourceGroupMember_ReadLegacyId(t *testing.T) {
+	config := setupMockInstance(t, map[string]string{
+		"4.0/groups/5":       `{"id":"5","name":"Sales team"}`,
+		"4.0/groups/5/users": `[{"id":"60","first_name":"Jane","last_name":"Doe"},{"id":"61","first_name":"John","last_name":"Doe"}]`,
+	})
+	d := resourceGroupMember().TestResourceData()
+	d.SetId("-")
+	d.Set("target_group_id", "5")
+	d.Set("user", []interface{}{map[string]interface{}{"id": "60"}})
+
+	if diags := resourceGroupMemberRead(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("read returned error: %v", diags)
+	}
+	if d.Id() != "5" {
+		t.Errorf("id = %q, expected 5", d.Id())
+	}
+	// Only the managed members are kept in state.
+	if got, want := setIds(d, "user"), []string{"60"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("user ids = %v, expected %v", got, want)
+	}
+}
+
+func TestResourceRoleGroups_Import(t *testing.T) {
+	config := setupMockInstance(t, map[string]string{
+		"4.0/roles/9/groups": `[{"id":"5","name":"Sales team"},{"id":"7","name":"Sales EMEA"}]`,
+	})
+
+	d := importAndRead(t, resourceRoleGroups(), "9", config)
+
+	if got := d.Get("role_id"); got != "9" {
+		t.Errorf("role_id = %v, expected 9", got)
+	}
+	if got, want := setIds(d, "group"), []string{"5", "7"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("group ids = %v, expected %v", got, want)
+	}
+}
+
+func TestResourceConnection_Import(t *testing.T) {
+	config := setupMockInstance(t, map[string]string{
+		"4.0/connections/thelook": `{"name":"thelook","host":"db.example.com","port":"5432","username":"looker","database":"demo","dialect_name":"postgres","pdt_concurrency":2}`,
+	})
+
+	d := importAndRead(t, resourceConnection(), "thelook", config)
+
+	for key, want := range map[string]string{"name": "thelook", "host": "db.example.com", "username": "looker", "database": "demo"} {
+		if got := d.Get(key); got != want {
+			t.Errorf("%s = %v, expected %v", key, got, want)
+		}
+	}
+	if got := d.Get("pdt_concurrency"); got != 2 {
+		t.Errorf("pdt_concurrency = %v, expected 2", got)
+	}
+}
+
+func TestResourceAlert_Import(t *testing.T) {
+	config := setupMockInstance(t, map[string]string{
+		"4.0/alerts/3": `{"id":"3","comparison_type":"GREATER_THAN","cron":"0 6 * * *","owner_id":"60","threshold":100,
+			"destinations":[{"destination_type":"EMAIL","email_address":"jane@example.com"}],
+			"field":{"title":"Orders Count","name":"orders.count"}}`,
+	})
+
+	d := importAndRead(t, resourceAlerts(), "3", config)
+
+	if got := d.Get("owner_id"); got != "60" {
+		t.Errorf("owner_id = %v, expected 60", got)
+	}
+	if got := d.Get("treshold"); got != 100.0 {
+		t.Errorf("treshold = %v, expected 100", got)
+	}
+	if got := d.Get("cron"); got != "0 6 * * *" {
+		t.Errorf("cron = %v, expected 0 6 * * *", got)
+	}
+}
+
+func TestResourceProjectGitRepo_Import(t *testing.T) {
+	config := setupMockInstance(t, map[string]string{
+		"4.0/projects/thelook": `{"id":"thelook","name":"thelook","git_remote_url":"git@github.com:example/thelook.git","git_service_name":"github","git_production_branch_name":"main"}`,
+	})
+
+	d := importAndRead(t, resourceProjectGitRepo(), "thelook", config)
+
+	for key, want := range map[string]string{"project_id": "thelook", "git_remote_url": "git@github.com:example/thelook.git", "git_production_branch_name": "main"} {
+		if got := d.Get(key); got != want {
+			t.Errorf("%s = %v, expected %v", key, got, want)
+		}
+	}
+}
@@ -0,0 +1,330 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/devoteamgcloud/terraform-provider-looker/pkg/lookergo"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const ldapConfigId = "ldap_config"
+
+func resourceLDAPConfig() *schema.Resource {
+	s := authConfigSchema("LDAP")
+	s["connection_host"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Hostname of the LDAP server",
+	}
+	s["connection_port"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Port of the LDAP server",
+	}
+	s["connection_tls"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Use TLS to connect to the LDAP server",
+	}
+	s["connection_tls_no_verify"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Do not verify the certificate of the LDAP server",
+	}
+	s["auth_username"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Distinguished name of the account used to access the LDAP server. Anonymous access is used when not set.",
+	}
+	s["auth_password"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Sensitive:   true,
+		Description: "Password of the account used to access the LDAP server. Looker does not return it, so changes made outside of Terraform are not detected.",
+	}
+	s["force_no_page"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Do not page search results, even if the LDAP server supports it",
+	}
+	s["user_bind_base_dn"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Distinguished name of the node users are searched in",
+	}
+	s["user_id_attribute_names"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Comma separated names of the user attributes matched against the login",
+	}
+	s["user_objectclass"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Object class of users",
+	}
+	s["user_custom_filter"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Additional RFC-2254 filter used to find users",
+	}
+	s["user_attribute_map_ldap_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Name of the LDAP user attribute holding the unique ID of users",
+	}
+	s["groups_base_dn"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Distinguished name of the node groups are searched in",
+	}
+	s["groups_finder_type"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		Description: "How Looker searches the groups of users",
+	}
+	s["groups_member_attribute"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Group attribute listing the members, usually `member`",
+	}
+	s["groups_objectclasses"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Comma separated object classes of groups",
+	}
+	s["groups_user_attribute"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "User attribute referenced by `groups_member_attribute`, usually `dn`",
+	}
+	s["merge_new_users_by_email"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Merge the first LDAP login of a user into the existing user with the same email. Otherwise a new user is created.",
+	}
+	s["test_ldap_user"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Login of a user whose information and authentication are checked by `test_config`",
+	}
+	s["test_ldap_password"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Sensitive:    true,
+		RequiredWith: []string{"test_ldap_user"},
+		Description:  "Password of `test_ldap_user`, to also test the authentication of the user",
+	}
+
+	return &schema.Resource{
+		Description: `Manages the LDAP authentication configuration of the instance.
+
+There is a single LDAP configuration per instance: declare this resource at most once. Destroying it disables LDAP authentication but leaves the rest of the configuration in place.
+
+With ` + "`test_config`" + `, the connection to the LDAP server is tested, then the authentication of ` + "`auth_username`" + ` when set, then the information and authentication of ` + "`test_ldap_user`" + ` when set.
+`,
+		CreateContext: resourceLDAPConfigCreate,
+		ReadContext:   resourceLDAPConfigRead,
+		UpdateContext: resourceLDAPConfigUpdate,
+		DeleteContext: resourceLDAPConfigDelete,
+		Schema:        s,
+		Importer:      importAuthConfig(ldapConfigId),
+	}
+}
+
+func expandLDAPConfig(d *schema.ResourceData) *lookergo.LDAPConfig {
+	config := &lookergo.LDAPConfig{
+		Enabled:                    boolPtr(d.Get("enabled").(bool)),
+		ConnectionHost:             castToPtr(d.Get("connection_host").(string)),
+		ConnectionPort:             castToPtr(d.Get("connection_port").(string)),
+		ConnectionTls:              boolPtr(d.Get("connection_tls").(bool)),
+		ConnectionTlsNoVerify:      boolPtr(d.Get("connection_tls_no_verify").(bool)),
+		AuthUsername:               castToPtr(d.Get("auth_username").(string)),
+		AuthPassword:               castToPtr(d.Get("auth_password").(string)),
+		ForceNoPage:                boolPtr(d.Get("force_no_page").(bool)),
+		UserBindBaseDn:             castToPtr(d.Get("user_bind_base_dn").(string)),
+		UserIdAttributeNames:       castToPtr(d.Get("user_id_attribute_names").(string)),
+		UserObjectclass:            castToPtr(d.Get("user_objectclass").(string)),
+		UserCustomFilter:           castToPtr(d.Get("user_custom_filter").(string)),
+		UserAttributeMapEmail:      castToPtr(d.Get("user_attribute_map_email").(string)),
+		UserAttributeMapFirstName:  castToPtr(d.Get("user_attribute_map_first_name").(string)),
+		UserAttributeMapLastName:   castToPtr(d.Get("user_attribute_map_last_name").(string)),
+		UserAttributeMapLdapId:     castToPtr(d.Get("user_attribute_map_ldap_id").(string)),
+		GroupsBaseDn:               castToPtr(d.Get("groups_base_dn").(string)),
+		GroupsMemberAttribute:      castToPtr(d.Get("groups_member_attribute").(string)),
+		GroupsObjectclasses:        castToPtr(d.Get("groups_objectclasses").(string)),
+		GroupsUserAttribute:        castToPtr(d.Get("groups_user_attribute").(string)),
+		MergeNewUsersByEmail:       boolPtr(d.Get("merge_new_users_by_email").(bool)),
+		AlternateEmailLoginAllowed: boolPtr(d.Get("alternate_email_login_allowed").(bool)),
+		DefaultNewUserRoleIds:      castToPtr(schemaSetToStringSlice(d.Get("default_new_user_role_ids").(*schema.Set))),
+		DefaultNewUserGroupIds:     castToPtr(schemaSetToStringSlice(d.Get("default_new_user_group_ids").(*schema.Set))),
+		SetRolesFromGroups:         boolPtr(d.Get("set_roles_from_groups").(bool)),
+		GroupsWithRoleIds:          expandAuthGroupMappings(d.Get("group_mapping").([]interface{})),
+		AuthRequiresRole:           boolPtr(d.Get("auth_requires_role").(bool)),
+		UserAttributesWithIds:      expandAuthUserAttributeMappings(d.Get("user_attribute_mapping").(*schema.Set)),
+		AllowNormalGroupMembership: boolPtr(d.Get("allow_normal_group_membership").(bool)),
+		AllowRolesFromNormalGroups: boolPtr(d.Get("allow_roles_from_normal_groups").(bool)),
+		AllowDirectRoles:           boolPtr(d.Get("allow_direct_roles").(bool)),
+	}
+	if v, ok := d.GetOk("groups_finder_type"); ok {
+		config.GroupsFinderType = castToPtr(v.(string))
+	}
+	return config
+}
+
+// ldapTestResultDiags converts the result of an LDAP test to diagnostics: issues with the error severity and failed
+// tests are errors, other issues are warnings.
+func ldapTestResultDiags(test string, result *lookergo.LDAPConfigTestResult) (diags diag.Diagnostics) {
+	if result.Status == lookergo.LDAPConfigTestStatus_ERROR {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("LDAP %s test failed: %s", test, result.Message),
+			Detail:   result.Details,
+		})
+	}
+	for _, issue := range result.Issues {
+		severity := diag.Warning
+		if issue.Severity == lookergo.LDAPConfigTestSeverity_ERROR {
+			severity = diag.Error
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: severity,
+			Summary:  fmt.Sprintf("LDAP %s test: %s", test, issue.Message),
+		})
+	}
+	return diags
+}
+
+// ldapTest is one of the tests of an LDAP configuration.
+type ldapTest struct {
+	name string
+	run  func(context.Context, *lookergo.LDAPConfig) (*lookergo.LDAPConfigTestResult, *lookergo.Response, error)
+}
+
+// testLDAPConfig runs the LDAP tests that apply to the configuration, stopping at the first failing one as the next
+// tests depend on it.
+func testLDAPConfig(ctx context.Context, c *lookergo.Client, d *schema.ResourceData, config *lookergo.LDAPConfig) (diags diag.Diagnostics) {
+	tests := []ldapTest{{"connection", c.LDAPConfig.TestConnection}}
+	if d.Get("auth_username").(string) != "" {
+		tests = append(tests, ldapTest{"auth", c.LDAPConfig.TestAuth})
+	}
+	if user := d.Get("test_ldap_user").(string); user != "" {
+		config.TestLdapUser = castToPtr(user)
+		tests = append(tests, ldapTest{"user info", c.LDAPConfig.TestUserInfo})
+		if password := d.Get("test_ldap_password").(string); password != "" {
+			config.TestLdapPassword = castToPtr(password)
+			tests = append(tests, ldapTest{"user auth", c.LDAPConfig.TestUserAuth})
+		}
+	}
+	defer func() {
+		config.TestLdapUser, config.TestLdapPassword = nil, nil
+	}()
+
+	for _, test := range tests {
+		tflog.Info(ctx, "Testing Looker LDAP config", map[string]interface{}{"test": test.name})
+		result, _, err := test.run(ctx, config)
+		if err != nil {
+			return diagErrAppend(diags, err)
+		}
+		if diags = append(diags, ldapTestResultDiags(test.name, result)...); diags.HasError() {
+			return diags
+		}
+	}
+	return diags
+}
+
+func applyLDAPConfig(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+
+	config := expandLDAPConfig(d)
+	if d.Get("test_config").(bool) {
+		if diags = testLDAPConfig(ctx, c, d, config); diags.HasError() {
+			return diags
+		}
+	}
+	// The password is write-only, only send it when it changed.
+	if !d.IsNewResource() && !d.HasChange("auth_password") {
+		config.AuthPassword = nil
+	}
+
+	tflog.Info(ctx, "Updating Looker LDAP config")
+	if _, _, err := c.LDAPConfig.Update(ctx, config); err != nil {
+		return diagErrAppend(diags, err)
+	}
+	d.SetId(ldapConfigId)
+
+	return append(diags, resourceLDAPConfigRead(ctx, d, m)...)
+}
+
+func resourceLDAPConfigCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return applyLDAPConfig(ctx, d, m)
+}
+
+func resourceLDAPConfigRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+
+	config, _, err := c.LDAPConfig.Get(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return setAuthConfigAttributes(d, map[string]interface{}{
+		"enabled":                        valueFromPtr(config.Enabled),
+		"connection_host":                valueFromPtr(config.ConnectionHost),
+		"connection_port":                valueFromPtr(config.ConnectionPort),
+		"connection_tls":                 valueFromPtr(config.ConnectionTls),
+		"connection_tls_no_verify":       valueFromPtr(config.ConnectionTlsNoVerify),
+		"auth_username":                  valueFromPtr(config.AuthUsername),
+		"force_no_page":                  valueFromPtr(config.ForceNoPage),
+		"user_bind_base_dn":              valueFromPtr(config.UserBindBaseDn),
+		"user_id_attribute_names":        valueFromPtr(config.UserIdAttributeNames),
+		"user_objectclass":               valueFromPtr(config.UserObjectclass),
+		"user_custom_filter":             valueFromPtr(config.UserCustomFilter),
+		"user_attribute_map_email":       valueFromPtr(config.UserAttributeMapEmail),
+		"user_attribute_map_first_name":  valueFromPtr(config.UserAttributeMapFirstName),
+		"user_attribute_map_last_name":   valueFromPtr(config.UserAttributeMapLastName),
+		"user_attribute_map_ldap_id":     valueFromPtr(config.UserAttributeMapLdapId),
+		"groups_base_dn":                 valueFromPtr(config.GroupsBaseDn),
+		"groups_finder_type":             valueFromPtr(config.GroupsFinderType),
+		"groups_member_attribute":        valueFromPtr(config.GroupsMemberAttribute),
+		"groups_objectclasses":           valueFromPtr(config.GroupsObjectclasses),
+		"groups_user_attribute":          valueFromPtr(config.GroupsUserAttribute),
+		"merge_new_users_by_email":       valueFromPtr(config.MergeNewUsersByEmail),
+		"alternate_email_login_allowed":  valueFromPtr(config.AlternateEmailLoginAllowed),
+		"default_new_user_role_ids":      flattenRoleIds(config.DefaultNewUserRoles),
+		"default_new_user_group_ids":     flattenGroupIds(config.DefaultNewUserGroups),
+		"set_roles_from_groups":          valueFromPtr(config.SetRolesFromGroups),
+		"group_mapping":                  flattenAuthGroupMappings(config.GroupsWithRoleIds, d.Get("group_mapping").([]interface{})),
+		"auth_requires_role":             valueFromPtr(config.AuthRequiresRole),
+		"user_attribute_mapping":         flattenAuthUserAttributeMappings(config.UserAttributesWithIds),
+		"allow_normal_group_membership":  valueFromPtr(config.AllowNormalGroupMembership),
+		"allow_roles_from_normal_groups": valueFromPtr(config.AllowRolesFromNormalGroups),
+		"allow_direct_roles":             valueFromPtr(config.AllowDirectRoles),
+		"modified_at":                    valueFromPtr(config.ModifiedAt),
+		"modified_by":                    valueFromPtr(config.ModifiedBy),
+	})
+}
+
+func resourceLDAPConfigUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return applyLDAPConfig(ctx, d, m)
+}
+
+func resourceLDAPConfigDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+
+	tflog.Info(ctx, "Disabling Looker LDAP authentication")
+	if _, _, err := c.LDAPConfig.Update(ctx, &lookergo.LDAPConfig{Enabled: boolPtr(false)}); err != nil {
+		return diag.FromErr(err)
+	}
+	// Finally mark as deleted
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,320 @@
+package provider
+
+import (
+	"context"
+	"github.com/devoteamgcloud/terraform-provider-looker/pkg/lookergo"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"strconv"
+)
+
+func resourceLook() *schema.Resource {
+	return &schema.Resource{
+		Description: `Manages a look, a saved query with a title in a folder.
+
+Queries are immutable in Looker: changing the ` + "`query`" + ` block creates a new query and points the look to it.
+`,
+		CreateContext: resourceLookCreate,
+		ReadContext:   resourceLookRead,
+		UpdateContext: resourceLookUpdate,
+		DeleteContext: resourceLookDelete,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the look",
+			},
+			"title": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+				Description:  "Look title",
+			},
+			"folder_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the folder the look is in. Changing it moves the look.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description",
+			},
+			"is_run_on_load": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Auto-run the query when the look is viewed",
+			},
+			"public": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Is public",
+			},
+			"query": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "Query of the look",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"model": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "LookML model name",
+						},
+						"view": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Explore name",
+						},
+						"fields": {
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Fields to select, e.g. `order_items.created_date`.",
+						},
+						"filters": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Filters, as a map of field names to Looker filter expressions.",
+						},
+						"filter_expression": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Custom filter expression",
+						},
+						"sorts": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Sorting for the query results. Use `view.field desc` to sort in descending order.",
+						},
+						"pivots": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Fields to pivot on",
+						},
+						"limit": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "Row limit. Set to -1 for unlimited results.",
+						},
+						"total": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Show column totals",
+						},
+						"dynamic_fields": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsJSON,
+							Description:  "Custom fields and table calculations, as a JSON string.",
+						},
+						"query_timezone": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Timezone the query runs in",
+						},
+					},
+				},
+			},
+			"query_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the query of the look",
+			},
+			"user_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the user who owns the look",
+			},
+			"content_metadata_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the content metadata",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func expandLookQuery(raw map[string]interface{}) *lookergo.Query {
+	query := &lookergo.Query{
+		Model:  raw["model"].(string),
+		View:   raw["view"].(string),
+		Fields: interfaceListToStringList(raw["fields"].([]interface{})),
+		Sorts:  interfaceListToStringList(raw["sorts"].([]interface{})),
+		Pivots: interfaceListToStringList(raw["pivots"].([]interface{})),
+		Total:  boolPtr(raw["total"].(bool)),
+	}
+	if filters := raw["filters"].(map[string]interface{}); len(filters) > 0 {
+		query.Filters = make(map[string]string, len(filters))
+		for field, value := range filters {
+			query.Filters[field] = value.(string)
+		}
+	}
+	if value := raw["filter_expression"].(string); value != "" {
+		query.FilterExpression = castToPtr(value)
+	}
+	if value := raw["limit"].(int); value != 0 {
+		query.Limit = castToPtr(strconv.Itoa(value))
+	}
+	if value := raw["dynamic_fields"].(string); value != "" {
+		query.DynamicFields = castToPtr(value)
+	}
+	if value := raw["query_timezone"].(string); value != "" {
+		query.QueryTimezone = castToPtr(value)
+	}
+	return query
+}
+
+func flattenLookQuery(query *lookergo.Query) []interface{} {
+	limit, _ := strconv.Atoi(valueFromPtr(query.Limit))
+	return []interface{}{map[string]interface{}{
+		"model":             query.Model,
+		"view":              query.View,
+		"fields":            query.Fields,
+		"filters":           query.Filters,
+		"filter_expression": valueFromPtr(query.FilterExpression),
+		"sorts":             query.Sorts,
+		"pivots":            query.Pivots,
+		"limit":             limit,
+		"total":             valueFromPtr(query.Total),
+		"dynamic_fields":    valueFromPtr(query.DynamicFields),
+		"query_timezone":    valueFromPtr(query.QueryTimezone),
+	}}
+}
+
+func createLookQuery(ctx context.Context, c *lookergo.Client, d *schema.ResourceData) (string, error) {
+	query := expandLookQuery(d.Get("query").([]interface{})[0].(map[string]interface{}))
+	tflog.Debug(ctx, "Creating Looker query", map[string]interface{}{"model": query.Model, "view": query.View})
+	newQuery, _, err := c.Queries.Create(ctx, query)
+	if err != nil {
+		return "", err
+	}
+	return newQuery.Id, nil
+}
+
+func resourceLookCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+
+	queryId, err := createLookQuery(ctx, c, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, "Creating Looker look")
+	look := &lookergo.Look{
+		Title:       d.Get("title").(string),
+		Description: castToPtr(d.Get("description").(string)),
+		FolderId:    d.Get("folder_id").(string),
+		QueryId:     queryId,
+		IsRunOnLoad: boolPtr(d.Get("is_run_on_load").(bool)),
+		Public:      boolPtr(d.Get("public").(bool)),
+	}
+	newLook, _, err := c.Looks.Create(ctx, look)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(newLook.Id)
+
+	tflog.Info(ctx, "Created Looker look", map[string]interface{}{"id": newLook.Id, "title": newLook.Title})
+
+	return resourceLookRead(ctx, d, m)
+}
+
+func resourceLookRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+
+	look, _, err := c.Looks.Get(ctx, d.Id())
+	if lookergo.IsNotFound(err) {
+		d.SetId("") // Mark as deleted
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if look.Deleted != nil && *look.Deleted {
+		// Soft deleted looks are in the trash and no longer managed.
+		d.SetId("")
+		return diags
+	}
+
+	query := look.Query
+	if query == nil {
+		if query, _, err = c.Queries.Get(ctx, look.QueryId); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.Set("title", look.Title)
+	d.Set("folder_id", look.FolderId)
+	d.Set("description", valueFromPtr(look.Description))
+	d.Set("is_run_on_load", valueFromPtr(look.IsRunOnLoad))
+	d.Set("public", valueFromPtr(look.Public))
+	d.Set("query_id", look.QueryId)
+	d.Set("user_id", look.UserId)
+	d.Set("content_metadata_id", look.ContentMetadataId)
+	if err = d.Set("query", flattenLookQuery(query)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceLookUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+	lookId := d.Id()
+
+	if d.HasChange("folder_id") {
+		folderId := d.Get("folder_id").(string)
+		tflog.Info(ctx, "Moving Looker look", map[string]interface{}{"id": lookId, "folder_id": folderId})
+		if _, _, err := c.Looks.Move(ctx, lookId, folderId); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChanges("title", "description", "is_run_on_load", "public", "query") {
+		look := &lookergo.Look{
+			Title:       d.Get("title").(string),
+			Description: castToPtr(d.Get("description").(string)),
+			IsRunOnLoad: boolPtr(d.Get("is_run_on_load").(bool)),
+			Public:      boolPtr(d.Get("public").(bool)),
+		}
+		if d.HasChange("query") {
+			queryId, err := createLookQuery(ctx, c, d)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			look.QueryId = queryId
+		}
+		if _, _, err := c.Looks.Update(ctx, lookId, look); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceLookRead(ctx, d, m)
+}
+
+func resourceLookDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+
+	if _, err := c.Looks.Delete(ctx, d.Id()); err != nil && !lookergo.IsNotFound(err) {
+		return diag.FromErr(err)
+	}
+	// Finally mark as deleted
+	d.SetId("")
+
+	return diags
+}
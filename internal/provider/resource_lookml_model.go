@@ -83,14 +83,14 @@ func resourceLookMlModelRead(ctx context.Context, d *schema.ResourceData, m inte
 	c := m.(*Config).Api // .(*lookergo.Client)
 	tflog.Trace(ctx, fmt.Sprintf("Fn: %v, Action: start", currFuncName()))
 	lmlMdlName := d.Get("name").(string)
-	newModel, response, err := c.LookMLModel.Get(ctx, lmlMdlName)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-	if response.StatusCode == 404 {
+	newModel, _, err := c.LookMLModel.Get(ctx, lmlMdlName)
+	if lookergo.IsNotFound(err) {
 		d.SetId("") // Mark as deleted
 		return diags
 	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	if newModel == nil {
 		return diag.FromErr(new(lookergo.ArgError))
 	}
@@ -0,0 +1,206 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/devoteamgcloud/terraform-provider-looker/pkg/lookergo"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const oidcConfigId = "oidc_config"
+
+func resourceOIDCConfig() *schema.Resource {
+	s := authConfigSchema("OpenID Connect")
+	s["issuer"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Issuer of the OpenID provider",
+	}
+	s["audience"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Audience of the OpenID provider",
+	}
+	s["identifier"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Client ID of Looker in the OpenID provider",
+	}
+	s["secret"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Sensitive:   true,
+		Description: "Client secret of Looker in the OpenID provider. Looker does not return it, so changes made outside of Terraform are not detected.",
+	}
+	s["authorization_endpoint"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Authorization URL of the OpenID provider",
+	}
+	s["token_endpoint"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Token URL of the OpenID provider",
+	}
+	s["userinfo_endpoint"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "User information URL of the OpenID provider",
+	}
+	s["scopes"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Scopes to request. Looker's default is used when not set.",
+	}
+	s["groups_attribute"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Name of the claim listing the groups of the user",
+	}
+	s["new_user_migration_types"] = newUserMigrationTypesSchema()
+
+	return &schema.Resource{
+		Description: `Manages the OpenID Connect authentication configuration of the instance.
+
+There is a single OpenID Connect configuration per instance: declare this resource at most once. Destroying it disables OpenID Connect authentication but leaves the rest of the configuration in place.
+`,
+		CreateContext: resourceOIDCConfigCreate,
+		ReadContext:   resourceOIDCConfigRead,
+		UpdateContext: resourceOIDCConfigUpdate,
+		DeleteContext: resourceOIDCConfigDelete,
+		Schema:        s,
+		Importer:      importAuthConfig(oidcConfigId),
+	}
+}
+
+func expandOIDCConfig(d *schema.ResourceData) *lookergo.OIDCConfig {
+	config := &lookergo.OIDCConfig{
+		Enabled:                    boolPtr(d.Get("enabled").(bool)),
+		Issuer:                     castToPtr(d.Get("issuer").(string)),
+		Audience:                   castToPtr(d.Get("audience").(string)),
+		Identifier:                 castToPtr(d.Get("identifier").(string)),
+		Secret:                     castToPtr(d.Get("secret").(string)),
+		AuthorizationEndpoint:      castToPtr(d.Get("authorization_endpoint").(string)),
+		TokenEndpoint:              castToPtr(d.Get("token_endpoint").(string)),
+		UserinfoEndpoint:           castToPtr(d.Get("userinfo_endpoint").(string)),
+		UserAttributeMapEmail:      castToPtr(d.Get("user_attribute_map_email").(string)),
+		UserAttributeMapFirstName:  castToPtr(d.Get("user_attribute_map_first_name").(string)),
+		UserAttributeMapLastName:   castToPtr(d.Get("user_attribute_map_last_name").(string)),
+		NewUserMigrationTypes:      expandNewUserMigrationTypes(d.Get("new_user_migration_types").(*schema.Set)),
+		AlternateEmailLoginAllowed: boolPtr(d.Get("alternate_email_login_allowed").(bool)),
+		DefaultNewUserRoleIds:      castToPtr(schemaSetToStringSlice(d.Get("default_new_user_role_ids").(*schema.Set))),
+		DefaultNewUserGroupIds:     castToPtr(schemaSetToStringSlice(d.Get("default_new_user_group_ids").(*schema.Set))),
+		SetRolesFromGroups:         boolPtr(d.Get("set_roles_from_groups").(bool)),
+		GroupsAttribute:            castToPtr(d.Get("groups_attribute").(string)),
+		GroupsWithRoleIds:          expandAuthGroupMappings(d.Get("group_mapping").([]interface{})),
+		AuthRequiresRole:           boolPtr(d.Get("auth_requires_role").(bool)),
+		UserAttributesWithIds:      expandAuthUserAttributeMappings(d.Get("user_attribute_mapping").(*schema.Set)),
+		AllowNormalGroupMembership: boolPtr(d.Get("allow_normal_group_membership").(bool)),
+		AllowRolesFromNormalGroups: boolPtr(d.Get("allow_roles_from_normal_groups").(bool)),
+		AllowDirectRoles:           boolPtr(d.Get("allow_direct_roles").(bool)),
+	}
+	if scopes := interfaceListToStringList(d.Get("scopes").([]interface{})); len(scopes) > 0 {
+		config.Scopes = &scopes
+	}
+	return config
+}
+
+// testOIDCConfig creates a test configuration, which Looker validates, then deletes it.
+func testOIDCConfig(ctx context.Context, c *lookergo.Client, config *lookergo.OIDCConfig) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "Testing Looker OIDC config")
+	test, _, err := c.OIDCConfig.CreateTestConfig(ctx, config)
+	if err != nil {
+		return diagErrAppend(diags, err)
+	}
+	if slug := valueFromPtr(test.TestSlug); slug != "" {
+		if _, err := c.OIDCConfig.DeleteTestConfig(ctx, slug); err != nil {
+			tflog.Warn(ctx, "Could not delete Looker OIDC test config", map[string]interface{}{"test_slug": slug, "error": err.Error()})
+		}
+	}
+	return diags
+}
+
+func applyOIDCConfig(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+
+	config := expandOIDCConfig(d)
+	if d.Get("test_config").(bool) {
+		if diags = testOIDCConfig(ctx, c, config); diags.HasError() {
+			return diags
+		}
+	}
+	// The secret is write-only, only send it when it changed.
+	if !d.IsNewResource() && !d.HasChange("secret") {
+		config.Secret = nil
+	}
+
+	tflog.Info(ctx, "Updating Looker OIDC config")
+	if _, _, err := c.OIDCConfig.Update(ctx, config); err != nil {
+		return diagErrAppend(diags, err)
+	}
+	d.SetId(oidcConfigId)
+
+	return append(diags, resourceOIDCConfigRead(ctx, d, m)...)
+}
+
+func resourceOIDCConfigCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return applyOIDCConfig(ctx, d, m)
+}
+
+func resourceOIDCConfigRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+
+	config, _, err := c.OIDCConfig.Get(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return setAuthConfigAttributes(d, map[string]interface{}{
+		"enabled":                        valueFromPtr(config.Enabled),
+		"issuer":                         valueFromPtr(config.Issuer),
+		"audience":                       valueFromPtr(config.Audience),
+		"identifier":                     valueFromPtr(config.Identifier),
+		"authorization_endpoint":         valueFromPtr(config.AuthorizationEndpoint),
+		"token_endpoint":                 valueFromPtr(config.TokenEndpoint),
+		"userinfo_endpoint":              valueFromPtr(config.UserinfoEndpoint),
+		"scopes":                         valueFromPtr(config.Scopes),
+		"user_attribute_map_email":       valueFromPtr(config.UserAttributeMapEmail),
+		"user_attribute_map_first_name":  valueFromPtr(config.UserAttributeMapFirstName),
+		"user_attribute_map_last_name":   valueFromPtr(config.UserAttributeMapLastName),
+		"new_user_migration_types":       flattenNewUserMigrationTypes(config.NewUserMigrationTypes),
+		"alternate_email_login_allowed":  valueFromPtr(config.AlternateEmailLoginAllowed),
+		"default_new_user_role_ids":      flattenRoleIds(config.DefaultNewUserRoles),
+		"default_new_user_group_ids":     flattenGroupIds(config.DefaultNewUserGroups),
+		"set_roles_from_groups":          valueFromPtr(config.SetRolesFromGroups),
+		"groups_attribute":               valueFromPtr(config.GroupsAttribute),
+		"group_mapping":                  flattenAuthGroupMappings(config.GroupsWithRoleIds, d.Get("group_mapping").([]interface{})),
+		"auth_requires_role":             valueFromPtr(config.AuthRequiresRole),
+		"user_attribute_mapping":         flattenAuthUserAttributeMappings(config.UserAttributesWithIds),
+		"allow_normal_group_membership":  valueFromPtr(config.AllowNormalGroupMembership),
+		"allow_roles_from_normal_groups": valueFromPtr(config.AllowRolesFromNormalGroups),
+		"allow_direct_roles":             valueFromPtr(config.AllowDirectRoles),
+		"modified_at":                    valueFromPtr(config.ModifiedAt),
+		"modified_by":                    valueFromPtr(config.ModifiedBy),
+	})
+}
+
+func resourceOIDCConfigUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return applyOIDCConfig(ctx, d, m)
+}
+
+func resourceOIDCConfigDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+
+	tflog.Info(ctx, "Disabling Looker OIDC authentication")
+	if _, _, err := c.OIDCConfig.Update(ctx, &lookergo.OIDCConfig{Enabled: boolPtr(false)}); err != nil {
+		return diag.FromErr(err)
+	}
+	// Finally mark as deleted
+	d.SetId("")
+
+	return diags
+}
@@ -74,8 +74,8 @@ func resourcePermissionSetRead(ctx context.Context, d *schema.ResourceData, m in
 	c := m.(*Config).Api // .(*lookergo.Client)
 
 	permissionSetID := d.Id()
-	permissionSet, response, err := c.PermissionSets.Get(ctx, permissionSetID)
-	if response.StatusCode == 404 {
+	permissionSet, _, err := c.PermissionSets.Get(ctx, permissionSetID)
+	if lookergo.IsNotFound(err) {
 		d.SetId("") // Mark as deleted
 		return diags
 	}
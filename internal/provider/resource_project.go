@@ -110,9 +110,8 @@ func resourceProjectRead(ctx context.Context, d *schema.ResourceData, m interfac
 	tflog.Trace(ctx, fmt.Sprintf("Fn: %v, Action: start", currFuncName()))
 
 	projectId := d.Id()
-	var project *lookergo.Project
-	project, response, err := dc.Projects.Get(ctx, projectId)
-	if response.StatusCode == 404 {
+	project, _, err := dc.Projects.Get(ctx, projectId)
+	if lookergo.IsNotFound(err) {
 		d.SetId("") // Mark as deleted
 		return diags
 	}
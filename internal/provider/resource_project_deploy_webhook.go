@@ -0,0 +1,172 @@
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/devoteamgcloud/terraform-provider-looker/pkg/lookergo"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceProjectDeployWebhook() *schema.Resource {
+	return &schema.Resource{
+		Description: `Secures the deploy webhook of a project with a secret, and exports the webhook URL. A git service calling the
+webhook with the secret in the ` + "`X-Looker-Deploy-Secret`" + ` header deploys the production branch; append ` + "`/branch/<name>`" + ` or
+` + "`/ref/<ref>`" + ` to the URL to deploy another branch or ref. The secret is unset on destroy, which leaves the webhook
+unauthenticated.
+
+Don't set ` + "`deploy_secret`" + ` of looker_project_git_repo for the same project. The API doesn't return the secret, so it is
+unknown after import until it is rotated.
+`,
+		CreateContext: resourceProjectDeployWebhookCreate,
+		ReadContext:   resourceProjectDeployWebhookRead,
+		UpdateContext: resourceProjectDeployWebhookUpdate,
+		DeleteContext: resourceProjectDeployWebhookDelete,
+		CustomizeDiff: resourceProjectDeployWebhookCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"project_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the project",
+			},
+			"secret": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Secret the webhook requires. Generated when not set",
+			},
+			"rotation_trigger": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Arbitrary value; changing it generates a new secret, unless `secret` is set. For instance the ID of a `time_rotating` resource",
+			},
+			"webhook_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "URL of the webhook deploying the production branch",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				d.Set("project_name", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+	}
+}
+
+// generateDeploySecret returns a random secret of 32 bytes, hex encoded.
+func generateDeploySecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// setDeploySecret sets secret as the deploy secret of the project, or a generated one when secret is empty.
+func setDeploySecret(ctx context.Context, d *schema.ResourceData, m interface{}, secret string) error {
+	if err := ensureDevClient(ctx, m); err != nil {
+		return err
+	}
+	dc := m.(*Config).DevClient
+	if err := dc.EnsureStaticToken(ctx, m.(*Config).Api, m.(*Config).ApiUserID); err != nil {
+		return err
+	}
+
+	if secret == "" {
+		generated, err := generateDeploySecret()
+		if err != nil {
+			return err
+		}
+		secret = generated
+	}
+
+	projectName := d.Get("project_name").(string)
+	tflog.Info(ctx, "Setting Looker deploy secret", map[string]interface{}{"project": projectName})
+	if _, err := dc.Projects.SetDeploySecret(ctx, projectName, secret); err != nil {
+		return err
+	}
+	return d.Set("secret", secret)
+}
+
+// resourceProjectDeployWebhookCustomizeDiff marks the secret as unknown when it is about to be rotated, so that the
+// plan doesn't show the previous one to the resources reading it.
+func resourceProjectDeployWebhookCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() != "" && d.HasChange("rotation_trigger") && d.GetRawConfig().GetAttr("secret").IsNull() {
+		return d.SetNewComputed("secret")
+	}
+	return nil
+}
+
+func resourceProjectDeployWebhookCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	if err := setDeploySecret(ctx, d, m, d.Get("secret").(string)); err != nil {
+		return diagErrAppend(diags, err)
+	}
+	d.SetId(d.Get("project_name").(string))
+
+	return resourceProjectDeployWebhookRead(ctx, d, m)
+}
+
+func resourceProjectDeployWebhookRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	if err := ensureDevClient(ctx, m); err != nil {
+		return diagErrAppend(diags, err)
+	}
+	dc := m.(*Config).DevClient
+	if err := dc.EnsureStaticToken(ctx, m.(*Config).Api, m.(*Config).ApiUserID); err != nil {
+		return diagErrAppend(diags, err)
+	}
+
+	project, _, err := dc.Projects.Get(ctx, d.Id())
+	if lookergo.IsNotFound(err) {
+		d.SetId("") // Mark as deleted
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("project_name", project.Id)
+	d.Set("webhook_url", dc.Projects.DeployWebhookURL(project.Id))
+
+	return diags
+}
+
+func resourceProjectDeployWebhookUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	if d.HasChanges("secret", "rotation_trigger") {
+		// The state holds the previous secret when none is configured: generate a new one then.
+		var secret string
+		if !d.GetRawConfig().GetAttr("secret").IsNull() {
+			secret = d.Get("secret").(string)
+		}
+		if err := setDeploySecret(ctx, d, m, secret); err != nil {
+			return diagErrAppend(diags, err)
+		}
+	}
+
+	return resourceProjectDeployWebhookRead(ctx, d, m)
+}
+
+func resourceProjectDeployWebhookDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	if err := ensureDevClient(ctx, m); err != nil {
+		return diagErrAppend(diags, err)
+	}
+	dc := m.(*Config).DevClient
+	if err := dc.EnsureStaticToken(ctx, m.(*Config).Api, m.(*Config).ApiUserID); err != nil {
+		return diagErrAppend(diags, err)
+	}
+
+	tflog.Info(ctx, "Unsetting Looker deploy secret", map[string]interface{}{"project": d.Id()})
+	if _, err := dc.Projects.SetDeploySecret(ctx, d.Id(), ""); err != nil && !lookergo.IsNotFound(err) {
+		return diagErrAppend(diags, err)
+	}
+	// Finally mark as deleted
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// setupMockDeployWebhook serves project hub, recording the deploy secrets it is given in secrets. An unset secret is
+// recorded as "-".
+func setupMockDeployWebhook(t *testing.T, secrets *[]string) *Config {
+	mux, config := setupMockServer(t)
+	mux.HandleFunc("/api/4.0/projects/hub", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			var body struct {
+				DeploySecret      string `json:"deploy_secret"`
+				UnsetDeploySecret bool   `json:"unset_deploy_secret"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatal(err)
+			}
+			if body.UnsetDeploySecret {
+				*secrets = append(*secrets, "-")
+			} else {
+				*secrets = append(*secrets, body.DeploySecret)
+			}
+		}
+		w.Write([]byte(`{"id":"hub","name":"hub"}`))
+	})
+	return config
+}
+
+func TestResourceProjectDeployWebhook_Generated(t *testing.T) {
+	var secrets []string
+	config := setupMockDeployWebhook(t, &secrets)
+	resource := resourceProjectDeployWebhook()
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{"project_name": "hub"})
+	if diags := resource.CreateContext(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("create returned %v", diags)
+	}
+	secret := d.Get("secret").(string)
+	if len(secrets) != 1 || secrets[0] != secret || len(secret) != 64 {
+		t.Errorf("secrets = %v, secret = %q, expected a generated secret to be set", secrets, secret)
+	}
+	if url := config.DevClient.BaseURL.Scheme + "://" + config.DevClient.BaseURL.Host + "/webhooks/projects/hub/deploy"; d.Get("webhook_url") != url {
+		t.Errorf("webhook_url = %v, expected %s", d.Get("webhook_url"), url)
+	}
+
+	if diags := resource.DeleteContext(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("delete returned %v", diags)
+	}
+	if len(secrets) != 2 || secrets[1] != "-" {
+		t.Errorf("secrets = %v, expected the secret to be unset", secrets)
+	}
+}
+
+func TestResourceProjectDeployWebhook_Configured(t *testing.T) {
+	var secrets []string
+	config := setupMockDeployWebhook(t, &secrets)
+	resource := resourceProjectDeployWebhook()
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{"project_name": "hub", "secret": "s3cr3t"})
+	if diags := resource.CreateContext(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("create returned %v", diags)
+	}
+	if len(secrets) != 1 || secrets[0] != "s3cr3t" || d.Get("secret") != "s3cr3t" {
+		t.Errorf("secrets = %v, secret = %v, expected s3cr3t", secrets, d.Get("secret"))
+	}
+}
+
+func TestResourceProjectDeployWebhook_RotationDiff(t *testing.T) {
+	resource := resourceProjectDeployWebhook()
+
+	for _, tc := range []struct {
+		secret   cty.Value
+		computed bool
+	}{
+		{cty.NullVal(cty.String), true},
+		{cty.StringVal("s3cr3t"), false},
+	} {
+		raw := map[string]interface{}{"project_name": "hub", "rotation_trigger": "2"}
+		if !tc.secret.IsNull() {
+			raw["secret"] = tc.secret.AsString()
+		}
+		state := &terraform.InstanceState{
+			ID:         "hub",
+			Attributes: map[string]string{"id": "hub", "project_name": "hub", "secret": "s3cr3t", "rotation_trigger": "1"},
+			RawConfig: cty.ObjectVal(map[string]cty.Value{
+				"project_name":     cty.StringVal("hub"),
+				"secret":           tc.secret,
+				"rotation_trigger": cty.StringVal("2"),
+			}),
+		}
+
+		diff, err := resource.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), nil)
+		if err != nil {
+			t.Fatalf("diff returned %v", err)
+		}
+		if computed := diff.Attributes["secret"] != nil && diff.Attributes["secret"].NewComputed; computed != tc.computed {
+			t.Errorf("secret configured as %#v: secret computed = %v, expected %v", tc.secret, computed, tc.computed)
+		}
+	}
+}
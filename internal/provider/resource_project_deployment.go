@@ -0,0 +1,272 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/devoteamgcloud/terraform-provider-looker/pkg/lookergo"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceProjectDeployment() *schema.Resource {
+	return &schema.Resource{
+		Description: `Deploys a project to production, after validating its LookML. The apply fails without deploying if the validation reports errors, or warnings when they are not allowed.
+
+Without ` + "`ref`" + ` or ` + "`branch`" + `, the current dev branch of the API user is validated and deployed. Otherwise the ref or branch is validated on a temporary branch of the API user, created from it and deleted afterwards: the current dev branch of the API user is left untouched.
+
+The project is deployed when the resource is created and every time ` + "`ref`" + `, ` + "`branch`" + ` or ` + "`triggers`" + ` change. Destroying the resource does not change production.
+`,
+		CreateContext: resourceProjectDeploymentCreate,
+		ReadContext:   resourceProjectDeploymentRead,
+		UpdateContext: resourceProjectDeploymentUpdate,
+		DeleteContext: resourceProjectDeploymentDelete,
+		Schema: map[string]*schema.Schema{
+			"project_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the project",
+			},
+			"ref": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Commit SHA or tag to deploy",
+				ConflictsWith: []string{"branch"},
+			},
+			"branch": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Remote branch to deploy",
+				ConflictsWith: []string{"ref"},
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Arbitrary values that deploy the project again when they change",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"validate": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether to validate the LookML before deploying it",
+			},
+			"allow_warnings": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether to deploy despite LookML warnings. Defaults to the `allow_warnings` setting of the project",
+			},
+			"validate_content": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to run the content validator after deploying, and report the content broken by the deployment as warnings",
+			},
+			"project_digest": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Digest of the validated LookML",
+			},
+		},
+	}
+}
+
+func resourceProjectDeploymentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	if diags = deployProject(ctx, d, m); diags.HasError() {
+		return diags
+	}
+	d.SetId(d.Get("project_name").(string))
+
+	return append(diags, resourceProjectDeploymentRead(ctx, d, m)...)
+}
+
+func resourceProjectDeploymentRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	if err := ensureDevClient(ctx, m); err != nil {
+		return diagErrAppend(diags, err)
+	}
+	dc := m.(*Config).DevClient
+	if err := dc.EnsureStaticToken(ctx, m.(*Config).Api, m.(*Config).ApiUserID); err != nil {
+		return diagErrAppend(diags, err)
+	}
+
+	_, _, err := dc.Projects.Get(ctx, d.Id())
+	if lookergo.IsNotFound(err) {
+		d.SetId("") // Mark as deleted
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("project_name", d.Id())
+
+	return diags
+}
+
+func resourceProjectDeploymentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	if d.HasChanges("ref", "branch", "triggers") {
+		if diags = deployProject(ctx, d, m); diags.HasError() {
+			// Keep the previously deployed ref in the state.
+			d.Partial(true)
+			return diags
+		}
+	}
+
+	return append(diags, resourceProjectDeploymentRead(ctx, d, m)...)
+}
+
+func resourceProjectDeploymentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	// A deployment can't be undone: only remove it from the state.
+	d.SetId("")
+
+	return diags
+}
+
+// deployProject validates the LookML to deploy, then deploys it unless the validation failed.
+func deployProject(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	if err := ensureDevClient(ctx, m); err != nil {
+		return diagErrAppend(diags, err)
+	}
+	c := m.(*Config).Api // .(*lookergo.Client)
+	dc := m.(*Config).DevClient
+	if err := dc.EnsureStaticToken(ctx, c, m.(*Config).ApiUserID); err != nil {
+		return diagErrAppend(diags, err)
+	}
+	projectName := d.Get("project_name").(string)
+	ref, branch := d.Get("ref").(string), d.Get("branch").(string)
+
+	if d.Get("validate").(bool) {
+		allowWarnings := optionalBool(d, "allow_warnings")
+		if allowWarnings == nil {
+			project, _, err := dc.Projects.Get(ctx, projectName)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			allowWarnings = boolPtr(valueFromPtr(project.AllowWarnings))
+		}
+		var validation *lookergo.ProjectValidation
+		if target := ref + branch; target != "" {
+			if validation, diags = validateOnTemporaryBranch(ctx, dc, projectName, target); diags.HasError() {
+				return diags
+			}
+		} else {
+			tflog.Info(ctx, "Validating Looker project", map[string]interface{}{"project": projectName})
+			var err error
+			if validation, _, err = dc.Projects.Validate(ctx, projectName); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if diags = append(diags, projectValidationDiags(validation, *allowWarnings)...); diags.HasError() {
+			return diags
+		}
+		d.Set("project_digest", validation.ProjectDigest)
+	}
+
+	tflog.Info(ctx, "Deploying Looker project", map[string]interface{}{"project": projectName, "ref": ref, "branch": branch})
+	var err error
+	switch {
+	case ref != "":
+		_, _, err = dc.Projects.GitRefDeployToProduction(ctx, projectName, ref)
+	case branch != "":
+		_, _, err = dc.Projects.GitBranchDeployToProduction(ctx, projectName, branch)
+	default:
+		_, _, err = dc.Projects.DeployToProduction(ctx, projectName)
+	}
+	if err != nil {
+		return diagErrAppend(diags, err)
+	}
+
+	if d.Get("validate_content").(bool) {
+		validation, _, err := c.ContentValidation.Validate(ctx)
+		if err != nil {
+			return diagErrAppend(diags, err)
+		}
+		diags = append(diags, contentValidationDiags(validation)...)
+	}
+
+	return diags
+}
+
+// validateOnTemporaryBranch validates target on a temporary branch of the API user, created from it, so the current
+// dev branch is left untouched. The current branch is checked out again and the temporary one deleted afterwards;
+// failing to do so is a warning.
+func validateOnTemporaryBranch(ctx context.Context, dc *lookergo.Client, projectName, target string) (validation *lookergo.ProjectValidation, diags diag.Diagnostics) {
+	active, _, err := dc.Projects.GitBranchActiveGet(ctx, projectName)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	temporary := fmt.Sprintf("terraform-validate-%d", time.Now().UnixNano())
+	tflog.Info(ctx, "Creating Looker git branch", map[string]interface{}{"project": projectName, "name": temporary, "ref": target})
+	if _, _, err = dc.Projects.GitBranchCheckout(ctx, projectName, &lookergo.GitBranchRef{Name: temporary, Ref: target}); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer func() {
+		if _, _, err := dc.Projects.GitBranchUpdate(ctx, projectName, &lookergo.GitBranchRef{Name: active.Name}); err != nil {
+			diags = append(diags, diag.Diagnostic{Severity: diag.Warning, Summary: "Unable to check out git branch " + active.Name + " again", Detail: err.Error()})
+			return
+		}
+		if _, err := dc.Projects.GitBranchDelete(ctx, projectName, temporary); err != nil {
+			diags = append(diags, diag.Diagnostic{Severity: diag.Warning, Summary: "Unable to delete temporary git branch " + temporary, Detail: err.Error()})
+		}
+	}()
+
+	tflog.Info(ctx, "Validating Looker project", map[string]interface{}{"project": projectName, "ref": target})
+	if validation, _, err = dc.Projects.Validate(ctx, projectName); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	return validation, diags
+}
+
+// projectValidationDiags returns a diagnostic per LookML error and warning. Warnings are errors unless allowWarnings.
+func projectValidationDiags(validation *lookergo.ProjectValidation, allowWarnings bool) (diags diag.Diagnostics) {
+	for _, e := range validation.Errors {
+		severity := diag.Error
+		switch {
+		case e.IsError():
+		case e.Severity == lookergo.ProjectErrorSeverity_WARNING && allowWarnings:
+			severity = diag.Warning
+		case e.Severity != lookergo.ProjectErrorSeverity_WARNING:
+			continue
+		}
+		location := e.FilePath
+		if e.LineNumber != 0 {
+			location = fmt.Sprintf("%s:%d", e.FilePath, e.LineNumber)
+		}
+		detail := e.Message
+		if e.HelpUrl != "" {
+			detail += "\nSee " + e.HelpUrl
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: severity,
+			Summary:  fmt.Sprintf("LookML %s in %s", e.Severity, location),
+			Detail:   detail,
+		})
+	}
+	return diags
+}
+
+// contentValidationDiags returns a warning per broken look or dashboard.
+func contentValidationDiags(validation *lookergo.ContentValidation) (diags diag.Diagnostics) {
+	for _, content := range validation.ContentWithErrors {
+		var name string
+		switch {
+		case content.Look != nil:
+			name = fmt.Sprintf("look %q (%s)", content.Look.Title, content.Look.Id)
+		case content.Dashboard != nil:
+			name = fmt.Sprintf("dashboard %q (%s)", content.Dashboard.Title, content.Dashboard.Id)
+		default:
+			name = fmt.Sprintf("content %s", content.Id)
+		}
+		for _, e := range content.Errors {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Broken %s", name),
+				Detail:   e.Message,
+			})
+		}
+	}
+	return diags
+}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/devoteamgcloud/terraform-provider-looker/pkg/lookergo"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// setupMockDeployment serves project hub, whose LookML validation reports a warning. Git operations and deployments
+// are recorded in calls.
+func setupMockDeployment(t *testing.T, calls *[]string) *Config {
+	mux, config := setupMockServer(t)
+	mux.HandleFunc("/api/4.0/projects/hub", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":"hub","name":"hub","allow_warnings":false}`)
+	})
+	mux.HandleFunc("/api/4.0/projects/hub/git_branch", func(w http.ResponseWriter, r *http.Request) {
+		var branch lookergo.GitBranchRef
+		json.NewDecoder(r.Body).Decode(&branch)
+		switch r.Method {
+		case http.MethodPost:
+			*calls = append(*calls, "create "+branch.Ref)
+		case http.MethodPut:
+			*calls = append(*calls, "checkout "+branch.Name)
+		}
+		fmt.Fprint(w, `{"name":"dev-api-user"}`)
+	})
+	mux.HandleFunc("/api/4.0/projects/hub/git_branch/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/api/4.0/projects/hub/git_branch/terraform-validate-") {
+			*calls = append(*calls, "delete")
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/api/4.0/projects/hub/validate", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"project_digest":"abc","errors":[
+			{"severity":"warning","message":"Unused view","file_path":"hub/views/old.view.lkml","line_number":3},
+			{"severity":"info","message":"Validated"}
+		]}`)
+	})
+	mux.HandleFunc("/api/4.0/projects/hub/deploy_ref_to_production", func(w http.ResponseWriter, r *http.Request) {
+		*calls = append(*calls, "deploy "+r.URL.Query().Get("ref"))
+		w.WriteHeader(http.StatusNoContent)
+	})
+	return config
+}
+
+func TestResourceProjectDeployment_Warnings(t *testing.T) {
+	var calls []string
+	config := setupMockDeployment(t, &calls)
+	resource := resourceProjectDeployment()
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"project_name": "hub",
+		"ref":          "v1.2.0",
+	})
+	diags := resource.CreateContext(context.Background(), d, config)
+	if len(diags) != 1 || diags[0].Severity != diag.Error || diags[0].Summary != "LookML warning in hub/views/old.view.lkml:3" {
+		t.Errorf("diagnostics = %v, expected an error for the warning", diags)
+	}
+	if want := []string{"create v1.2.0", "checkout dev-api-user", "delete"}; !reflect.DeepEqual(calls, want) {
+		t.Errorf("calls = %v, expected %v", calls, want)
+	}
+	if d.Id() != "" {
+		t.Errorf("id = %q, expected the deployment to fail", d.Id())
+	}
+}
+
+func TestResourceProjectDeployment_AllowWarnings(t *testing.T) {
+	var calls []string
+	config := setupMockDeployment(t, &calls)
+	resource := resourceProjectDeployment()
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"project_name":   "hub",
+		"ref":            "v1.2.0",
+		"allow_warnings": true,
+	})
+	diags := resource.CreateContext(context.Background(), d, config)
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Errorf("diagnostics = %v, expected a warning", diags)
+	}
+	if want := []string{"create v1.2.0", "checkout dev-api-user", "delete", "deploy v1.2.0"}; !reflect.DeepEqual(calls, want) {
+		t.Errorf("calls = %v, expected %v", calls, want)
+	}
+	if d.Id() != "hub" || d.Get("project_digest") != "abc" {
+		t.Errorf("id = %q, project_digest = %v, expected hub and abc", d.Id(), d.Get("project_digest"))
+	}
+}
+
+func TestResourceProjectDeployment_NoValidation(t *testing.T) {
+	var calls []string
+	config := setupMockDeployment(t, &calls)
+	resource := resourceProjectDeployment()
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"project_name": "hub",
+		"ref":          "v1.2.0",
+		"validate":     false,
+	})
+	if diags := resource.CreateContext(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("create returned %v", diags)
+	}
+	if want := []string{"deploy v1.2.0"}; !reflect.DeepEqual(calls, want) {
+		t.Errorf("calls = %v, expected %v", calls, want)
+	}
+}
@@ -0,0 +1,226 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/devoteamgcloud/terraform-provider-looker/pkg/lookergo"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// gitBranchSchema returns the computed attributes of a branch, shared by the looker_project_git_branch resource and the
+// looker_project_git_branches data source.
+func gitBranchSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"resolved_ref": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Commit SHA the branch points to",
+		},
+		"remote_ref": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Commit SHA the remote branch points to",
+		},
+		"ahead_count": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Number of commits the local branch is ahead of the remote",
+		},
+		"behind_count": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Number of commits the local branch is behind the remote",
+		},
+		"commit_at": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "When the branch was last committed to, in seconds since the epoch",
+		},
+		"is_production": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the branch is the production branch",
+		},
+		"personal": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the branch is the personal branch of a developer",
+		},
+		"readonly": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the branch is read-only for the API user",
+		},
+	}
+}
+
+// flattenGitBranch returns the attributes of gitBranchSchema for branch.
+func flattenGitBranch(branch *lookergo.GitBranch) map[string]interface{} {
+	return map[string]interface{}{
+		"resolved_ref":  branch.Ref,
+		"remote_ref":    branch.RemoteRef,
+		"ahead_count":   int(branch.AheadCount),
+		"behind_count":  int(branch.BehindCount),
+		"commit_at":     int(branch.CommitAt),
+		"is_production": valueFromPtr(branch.IsProduction),
+		"personal":      valueFromPtr(branch.Personal),
+		"readonly":      valueFromPtr(branch.Readonly),
+	}
+}
+
+func resourceProjectGitBranch() *schema.Resource {
+	s := gitBranchSchema()
+	s["id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "ID of the branch, in the format `project_name:name`",
+	}
+	s["project_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Name of the project",
+	}
+	s["name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Name of the branch",
+	}
+	s["ref"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Branch, tag or commit SHA to create the branch from. Defaults to HEAD of the current dev branch of the API user",
+	}
+	return &schema.Resource{
+		Description: `Creates a shared git branch in a project, through the dev workspace of the API user, whose active branch is left unchanged. The branch is deleted on destroy.
+`,
+		CreateContext: resourceProjectGitBranchCreate,
+		ReadContext:   resourceProjectGitBranchRead,
+		DeleteContext: resourceProjectGitBranchDelete,
+		Schema:        s,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				projectName, name, err := parseTwoPartId(d.Id(), "project_name", "name")
+				if err != nil {
+					return nil, err
+				}
+				d.Set("project_name", projectName)
+				d.Set("name", name)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+	}
+}
+
+func resourceProjectGitBranchCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	if err := ensureDevClient(ctx, m); err != nil {
+		return diagErrAppend(diags, err)
+	}
+	dc := m.(*Config).DevClient
+	if err := dc.EnsureStaticToken(ctx, m.(*Config).Api, m.(*Config).ApiUserID); err != nil {
+		return diagErrAppend(diags, err)
+	}
+
+	projectName := d.Get("project_name").(string)
+	// Creating a branch also checks it out: keep the active branch of the API user to check it out again.
+	active, _, err := dc.Projects.GitBranchActiveGet(ctx, projectName)
+	if err != nil {
+		return diagErrAppend(diags, err)
+	}
+
+	branch := &lookergo.GitBranchRef{Name: d.Get("name").(string), Ref: d.Get("ref").(string)}
+	tflog.Info(ctx, "Creating Looker git branch", map[string]interface{}{"project": projectName, "name": branch.Name, "ref": branch.Ref})
+	if _, _, err = dc.Projects.GitBranchCheckout(ctx, projectName, branch); err != nil {
+		return diagErrAppend(diags, err)
+	}
+	d.SetId(buildTwoPartId(projectName, branch.Name))
+
+	tflog.Info(ctx, "Checking out Looker git branch", map[string]interface{}{"project": projectName, "name": active.Name})
+	if _, _, err = dc.Projects.GitBranchUpdate(ctx, projectName, &lookergo.GitBranchRef{Name: active.Name}); err != nil {
+		diags = append(diags, diag.Diagnostic{Severity: diag.Warning, Summary: "Unable to check out git branch " + active.Name + " again", Detail: err.Error()})
+	}
+
+	return append(diags, resourceProjectGitBranchRead(ctx, d, m)...)
+}
+
+func resourceProjectGitBranchRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	if err := ensureDevClient(ctx, m); err != nil {
+		return diagErrAppend(diags, err)
+	}
+	dc := m.(*Config).DevClient
+	if err := dc.EnsureStaticToken(ctx, m.(*Config).Api, m.(*Config).ApiUserID); err != nil {
+		return diagErrAppend(diags, err)
+	}
+
+	projectName, name, err := parseTwoPartId(d.Id(), "project_name", "name")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	branch, _, err := dc.Projects.GitBranchListByName(ctx, projectName, name)
+	if lookergo.IsNotFound(err) {
+		d.SetId("") // Mark as deleted
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("project_name", projectName)
+	d.Set("name", branch.Name)
+	for key, value := range flattenGitBranch(branch) {
+		if err = d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceProjectGitBranchDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	if err := ensureDevClient(ctx, m); err != nil {
+		return diagErrAppend(diags, err)
+	}
+	dc := m.(*Config).DevClient
+	if err := dc.EnsureStaticToken(ctx, m.(*Config).Api, m.(*Config).ApiUserID); err != nil {
+		return diagErrAppend(diags, err)
+	}
+
+	projectName, name, err := parseTwoPartId(d.Id(), "project_name", "name")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The current branch can't be deleted: go back to the personal branch of the API user first.
+	active, _, err := dc.Projects.GitBranchActiveGet(ctx, projectName)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if active.Name == name {
+		branches, _, err := dc.Projects.GitBranchesList(ctx, projectName, nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		for _, branch := range branches {
+			if valueFromPtr(branch.Personal) && !valueFromPtr(branch.Readonly) {
+				tflog.Info(ctx, "Checking out Looker git branch", map[string]interface{}{"project": projectName, "name": branch.Name})
+				if _, _, err = dc.Projects.GitBranchUpdate(ctx, projectName, &lookergo.GitBranchRef{Name: branch.Name}); err != nil {
+					return diag.FromErr(err)
+				}
+				break
+			}
+		}
+	}
+
+	tflog.Info(ctx, "Deleting Looker git branch", map[string]interface{}{"project": projectName, "name": name})
+	if _, err = dc.Projects.GitBranchDelete(ctx, projectName, name); err != nil && !lookergo.IsNotFound(err) {
+		return diagErrAppend(diags, err)
+	}
+	// Finally mark as deleted
+	d.SetId("")
+
+	return diags
+}
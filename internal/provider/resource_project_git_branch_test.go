@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/devoteamgcloud/terraform-provider-looker/pkg/lookergo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// setupMockGitBranches serves project hub, whose dev workspace is on the release branch once it's checked out.
+// Checkouts and deletions are recorded in calls.
+func setupMockGitBranches(t *testing.T, calls *[]string) *Config {
+	mux, config := setupMockServer(t)
+	active := "dev-api-user"
+	mux.HandleFunc("/api/4.0/projects/hub/git_branch", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			active = "release"
+			*calls = append(*calls, "create release")
+		case http.MethodPut:
+			active = "dev-api-user"
+			*calls = append(*calls, "checkout dev-api-user")
+		}
+		fmt.Fprintf(w, `{"name":%q}`, active)
+	})
+	mux.HandleFunc("/api/4.0/projects/hub/git_branches", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[
+			{"name":"release","ref":"abc","ahead_count":2,"personal":false},
+			{"name":"master","ref":"def","is_production":true,"readonly":true},
+			{"name":"dev-api-user","ref":"abc","personal":true,"owner_name":"API user"}
+		]`)
+	})
+	mux.HandleFunc("/api/4.0/projects/hub/git_branch/release", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			*calls = append(*calls, "delete release")
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		fmt.Fprint(w, `{"name":"release","ref":"abc","remote_ref":"def","ahead_count":2,"behind_count":1}`)
+	})
+	return config
+}
+
+func TestResourceProjectGitBranch(t *testing.T) {
+	var calls []string
+	config := setupMockGitBranches(t, &calls)
+	resource := resourceProjectGitBranch()
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"project_name": "hub",
+		"name":         "release",
+		"ref":          "v1.2.0",
+	})
+	if diags := resource.CreateContext(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("create returned %v", diags)
+	}
+	if d.Id() != "hub:release" || d.Get("ahead_count") != 2 || d.Get("behind_count") != 1 || d.Get("resolved_ref") != "abc" {
+		t.Errorf("id = %q, ahead_count = %v, behind_count = %v, resolved_ref = %v", d.Id(), d.Get("ahead_count"), d.Get("behind_count"), d.Get("resolved_ref"))
+	}
+
+	// The dev workspace of the API user is back on its branch
+	if want := []string{"create release", "checkout dev-api-user"}; !reflect.DeepEqual(calls, want) {
+		t.Errorf("calls = %v, expected %v", calls, want)
+	}
+
+	// The branch is checked out outside of Terraform: the personal branch is checked out before deleting it
+	if _, _, err := config.DevClient.Projects.GitBranchCheckout(context.Background(), "hub", &lookergo.GitBranchRef{Name: "release"}); err != nil {
+		t.Fatal(err)
+	}
+	if diags := resource.DeleteContext(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("delete returned %v", diags)
+	}
+	if want := []string{"create release", "checkout dev-api-user", "create release", "checkout dev-api-user", "delete release"}; !reflect.DeepEqual(calls, want) {
+		t.Errorf("calls = %v, expected %v", calls, want)
+	}
+	if d.Id() != "" {
+		t.Errorf("id = %q, expected the branch to be deleted", d.Id())
+	}
+}
+
+func TestDataSourceProjectGitBranches(t *testing.T) {
+	config := setupMockGitBranches(t, new([]string))
+	resource := dataSourceProjectGitBranches()
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{"project_name": "hub"})
+	if diags := resource.ReadContext(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("read returned %v", diags)
+	}
+	if names, want := d.Get("names"), []interface{}{"dev-api-user", "master", "release"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("names = %v, expected %v", names, want)
+	}
+	if d.Get("branches.1.is_production") != true || d.Get("branches.0.owner_name") != "API user" || d.Get("branches.2.ahead_count") != 2 {
+		t.Errorf("branches = %v", d.Get("branches"))
+	}
+}
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"github.com/devoteamgcloud/terraform-provider-looker/pkg/lookergo"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -76,6 +77,10 @@ func resourceProjectGitDeployKeyRead(ctx context.Context, d *schema.ResourceData
 	pubKey, _, err := c.Projects.GitDeployKeyGet(ctx, projectName)
 	if err != nil {
 		pubKey, _, err = dc.Projects.GitDeployKeyGet(ctx, projectName)
+		if lookergo.IsNotFound(err) {
+			d.SetId("") // Mark as deleted
+			return diags
+		}
 		if err != nil {
 			return logErrDiag(ctx, diags, "Could not read ssh public key", "err", err)
 		}
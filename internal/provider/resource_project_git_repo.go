@@ -74,7 +74,7 @@ func resourceProjectGitRepo() *schema.Resource {
 			"deploy_secret": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "Secret Value for Authentication Webhook",
+				Description: "Secret Value for Authentication Webhook. See also looker_project_deploy_webhook",
 			},
 			"deploy_branch": {
 				Type:     schema.TypeString,
@@ -87,7 +87,12 @@ func resourceProjectGitRepo() *schema.Resource {
 				Optional: true,
 			},
 		},
-		Importer: nil,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				d.Set("project_id", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 	}
 }
 
@@ -128,6 +133,10 @@ func resourceProjectGitRepoRead(ctx context.Context, d *schema.ResourceData, m i
 	projectName := d.Get("project_id").(string)
 
 	project, _, err := dc.Projects.Get(ctx, projectName)
+	if lookergo.IsNotFound(err) {
+		d.SetId("") // Mark as deleted
+		return diags
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -239,7 +248,7 @@ func resourceProjectGitRepoCreate(ctx context.Context, d *schema.ResourceData, m
 	d.SetId(projectName)
 
 	tflog.Trace(ctx, fmt.Sprintf("Fn: %v, Action: end", currFuncName()))
-	return resourceProjectRead(ctx, d, m)
+	return resourceProjectGitRepoRead(ctx, d, m)
 }
 
 func resourceProjectGitRepoUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
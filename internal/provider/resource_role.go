@@ -7,7 +7,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"net/http"
 	"strconv"
 )
 
@@ -60,23 +59,16 @@ func resourceRoleRead(ctx context.Context, d *schema.ResourceData, m interface{}
 	if _, err := strconv.Atoi(d.Id()); err != nil {
 		return diag.Errorf(fmt.Sprintf("Cannot convert %s to int.", d.Id()))
 	}
-	role, response, err := c.Roles.Get(ctx, idAsInt(d.Id()))
-	if response.StatusCode == 404 {
+	role, _, err := c.Roles.Get(ctx, idAsInt(d.Id()))
+	if lookergo.IsNotFound(err) {
+		logTrace(ctx, "role not found", "role_id", d.Id())
 		d.SetId("") // Mark as deleted
 		return diags
 	}
-	switch e := err.(type) {
-	case *lookergo.ErrorResponse:
-		if errResp := e.Response; errResp.StatusCode == http.StatusNotFound {
-			logTrace(ctx, "role not found", "role_id", d.Id())
-			d.SetId("")
-			return // Resource was not found.
-		}
-	case error:
+	if err != nil {
 		return logErrDiag(ctx, diags, "unable to query role", "role_id", d.Id()) // Connection error.
-	default:
-		logTrace(ctx, "role found", "role", role)
 	}
+	logTrace(ctx, "role found", "role", role)
 
 	d.Set("name", role.Name)
 	d.Set("permission_set_id", role.PermissionSet.Id)
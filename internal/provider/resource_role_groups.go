@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"strconv"
 
 	"github.com/devoteamgcloud/terraform-provider-looker/pkg/lookergo"
@@ -43,10 +42,37 @@ func resourceRoleGroups() *schema.Resource {
 				},
 			},
 		},
-		Importer: nil,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceRoleGroupsImport,
+		},
 	}
 }
 
+// resourceRoleGroupsImport imports all groups currently assigned to the role with the given id.
+func resourceRoleGroupsImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+
+	roleId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("invalid role id %q, expected a number", d.Id())
+	}
+	d.Set("role_id", d.Id())
+
+	roleMemberGroups, _, err := c.Roles.RoleGroupsList(ctx, roleId, nil)
+	if err != nil {
+		return nil, err
+	}
+	var groupItems []interface{}
+	for _, group := range roleMemberGroups {
+		groupItems = append(groupItems, map[string]interface{}{"id": idAsString(group.Id), "name": group.Name})
+	}
+	if err = d.Set("group", groupItems); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceRoleGroupsRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
 	c := m.(*Config).Api // .(*lookergo.Client)
 	tflog.Trace(ctx, fmt.Sprintf("Fn: %v, Action: start", currFuncName()))
@@ -55,18 +81,15 @@ func resourceRoleGroupsRead(ctx context.Context, d *schema.ResourceData, m inter
 	if ok {
 		// Fetch and verify existence
 		roleMemberGroups, _, err := c.Roles.RoleGroupsList(ctx, idAsInt(d.Get("role_id")), nil)
-		switch err.(type) {
-		case *lookergo.ErrorResponse:
-			if errResp := err.(*lookergo.ErrorResponse).Response; errResp.StatusCode == http.StatusNotFound {
-				logTrace(ctx, "role not found", "role_id", d.Get("role_id").(string))
-				d.SetId("")
-				return // Resource was not found.
-			}
-		case error:
+		if lookergo.IsNotFound(err) {
+			logTrace(ctx, "role not found", "role_id", d.Get("role_id").(string))
+			d.SetId("")
+			return // Resource was not found.
+		}
+		if err != nil {
 			return logErrDiag(ctx, diags, "unable to query role", "role_id", d.Get("role_id").(string)) // Connection error.
-		default:
-			logTrace(ctx, "role group members", "roleMemberGroups", roleMemberGroups)
 		}
+		logTrace(ctx, "role group members", "roleMemberGroups", roleMemberGroups)
 
 		// Flatten
 		var groupItems []interface{}
@@ -80,7 +103,8 @@ func resourceRoleGroupsRead(ctx context.Context, d *schema.ResourceData, m inter
 			}
 		}
 		d.Set("group", groupItems)
-		d.SetId("-")
+		// Resources created by earlier versions of the provider have the placeholder id "-".
+		d.SetId(d.Get("role_id").(string))
 	} else {
 		d.SetId("")
 	}
@@ -113,7 +137,7 @@ func resourceRoleGroupsCreate(ctx context.Context, d *schema.ResourceData, m int
 		return logErrDiag(ctx, diags, "Failed to update Role member Groups", "err", err)
 	}
 
-	d.SetId("-")
+	d.SetId(d.Get("role_id").(string))
 	return resourceRoleGroupsRead(ctx, d, m)
 }
 
@@ -146,7 +170,7 @@ func resourceRoleGroupsUpdate(ctx context.Context, d *schema.ResourceData, m int
 		return logErrDiag(ctx, diags, "Failed to update Role member Groups", "err", err)
 	}
 
-	d.SetId("-")
+	d.SetId(d.Get("role_id").(string))
 
 	tflog.Trace(ctx, fmt.Sprintf("Fn: %v, Action: end", currFuncName()))
 	return resourceRoleGroupsRead(ctx, d, m)
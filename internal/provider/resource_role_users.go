@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/devoteamgcloud/terraform-provider-looker/pkg/lookergo"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceRoleUsers() *schema.Resource {
+	return &schema.Resource{
+		Description: `Manages the users a role is assigned to directly.
+
+This resource is authoritative: users the role is assigned to directly that are not declared lose the role. Users that get the role through a group are not affected.
+Do not combine it with the ` + "`roles`" + ` argument of ` + "`looker_user`" + ` or with ` + "`looker_user_roles`" + ` for users of the same role.
+`,
+		CreateContext: resourceRoleUsersCreate,
+		ReadContext:   resourceRoleUsersRead,
+		UpdateContext: resourceRoleUsersUpdate,
+		DeleteContext: resourceRoleUsersDelete,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the role",
+			},
+			"role_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the role",
+			},
+			"user_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the users the role is assigned to. Leave empty to remove the role from all users it is assigned to directly.",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				if _, err := strconv.Atoi(d.Id()); err != nil {
+					return nil, fmt.Errorf("invalid role id %q, expected a number", d.Id())
+				}
+				d.Set("role_id", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+	}
+}
+
+func setRoleUsers(ctx context.Context, c *lookergo.Client, d *schema.ResourceData) error {
+	roleId, err := strconv.Atoi(d.Get("role_id").(string))
+	if err != nil {
+		return fmt.Errorf("role_id must be a number: %w", err)
+	}
+	userIds := schemaSetToStringSlice(d.Get("user_ids").(*schema.Set))
+
+	tflog.Info(ctx, "Setting Looker role users", map[string]interface{}{"role_id": roleId, "user_ids": userIds})
+	_, _, err = c.Roles.RoleUsersSet(ctx, roleId, userIds)
+	return err
+}
+
+func resourceRoleUsersCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+
+	if err := setRoleUsers(ctx, c, d); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(d.Get("role_id").(string))
+
+	return resourceRoleUsersRead(ctx, d, m)
+}
+
+func resourceRoleUsersRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+
+	roleId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	users, _, err := c.Roles.RoleDirectUsersList(ctx, roleId, nil)
+	if lookergo.IsNotFound(err) {
+		d.SetId("") // Mark as deleted
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	userIds := make([]string, len(users))
+	for i, user := range users {
+		userIds[i] = user.Id
+	}
+	d.Set("role_id", d.Id())
+	if err = d.Set("user_ids", userIds); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceRoleUsersUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+
+	if err := setRoleUsers(ctx, c, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceRoleUsersRead(ctx, d, m)
+}
+
+func resourceRoleUsersDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+
+	roleId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Info(ctx, "Removing Looker role from all users", map[string]interface{}{"role_id": roleId})
+	if _, _, err = c.Roles.RoleUsersSet(ctx, roleId, []string{}); err != nil && !lookergo.IsNotFound(err) {
+		return diag.FromErr(err)
+	}
+	// Finally mark as deleted
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,206 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/devoteamgcloud/terraform-provider-looker/pkg/lookergo"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const samlConfigId = "saml_config"
+
+func resourceSamlConfig() *schema.Resource {
+	s := authConfigSchema("SAML")
+	s["idp_url"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Single sign-on URL of the identity provider",
+	}
+	s["idp_issuer"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Issuer of the identity provider",
+	}
+	s["idp_cert"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Certificate of the identity provider",
+	}
+	s["idp_audience"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Audience set in the identity provider. Looker validates the audience of assertions when set.",
+	}
+	s["allowed_clock_drift"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.IntAtLeast(0),
+		Description:  "Seconds of clock drift allowed when validating the timestamps of assertions",
+	}
+	s["groups_finder_type"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      lookergo.SamlGroupsFinderType_GROUPED_ATTRIBUTE_VALUES,
+		ValidateFunc: validation.StringInSlice([]string{lookergo.SamlGroupsFinderType_GROUPED_ATTRIBUTE_VALUES, lookergo.SamlGroupsFinderType_INDIVIDUAL_ATTRIBUTES}, false),
+		Description:  "How groups are found in assertions: `grouped_attribute_values` (one attribute, `groups_attribute`, lists the groups) or `individual_attributes` (one attribute per group, set to `groups_member_value` for members)",
+	}
+	s["groups_attribute"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Name of the attribute listing the groups of the user, with `grouped_attribute_values`",
+	}
+	s["groups_member_value"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Value of a group attribute for members of the group, with `individual_attributes`",
+	}
+	s["bypass_login_page"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Redirect to the identity provider instead of showing the login page",
+	}
+	s["new_user_migration_types"] = newUserMigrationTypesSchema()
+
+	return &schema.Resource{
+		Description: `Manages the SAML authentication configuration of the instance.
+
+There is a single SAML configuration per instance: declare this resource at most once. Destroying it disables SAML authentication but leaves the rest of the configuration in place.
+`,
+		CreateContext: resourceSamlConfigCreate,
+		ReadContext:   resourceSamlConfigRead,
+		UpdateContext: resourceSamlConfigUpdate,
+		DeleteContext: resourceSamlConfigDelete,
+		Schema:        s,
+		Importer:      importAuthConfig(samlConfigId),
+	}
+}
+
+func expandSamlConfig(d *schema.ResourceData) *lookergo.SamlConfig {
+	config := &lookergo.SamlConfig{
+		Enabled:                    boolPtr(d.Get("enabled").(bool)),
+		IdpUrl:                     castToPtr(d.Get("idp_url").(string)),
+		IdpIssuer:                  castToPtr(d.Get("idp_issuer").(string)),
+		IdpCert:                    castToPtr(d.Get("idp_cert").(string)),
+		IdpAudience:                castToPtr(d.Get("idp_audience").(string)),
+		UserAttributeMapEmail:      castToPtr(d.Get("user_attribute_map_email").(string)),
+		UserAttributeMapFirstName:  castToPtr(d.Get("user_attribute_map_first_name").(string)),
+		UserAttributeMapLastName:   castToPtr(d.Get("user_attribute_map_last_name").(string)),
+		NewUserMigrationTypes:      expandNewUserMigrationTypes(d.Get("new_user_migration_types").(*schema.Set)),
+		AlternateEmailLoginAllowed: boolPtr(d.Get("alternate_email_login_allowed").(bool)),
+		DefaultNewUserRoleIds:      castToPtr(schemaSetToStringSlice(d.Get("default_new_user_role_ids").(*schema.Set))),
+		DefaultNewUserGroupIds:     castToPtr(schemaSetToStringSlice(d.Get("default_new_user_group_ids").(*schema.Set))),
+		SetRolesFromGroups:         boolPtr(d.Get("set_roles_from_groups").(bool)),
+		GroupsFinderType:           castToPtr(d.Get("groups_finder_type").(string)),
+		GroupsAttribute:            castToPtr(d.Get("groups_attribute").(string)),
+		GroupsMemberValue:          castToPtr(d.Get("groups_member_value").(string)),
+		GroupsWithRoleIds:          expandAuthGroupMappings(d.Get("group_mapping").([]interface{})),
+		AuthRequiresRole:           boolPtr(d.Get("auth_requires_role").(bool)),
+		UserAttributesWithIds:      expandAuthUserAttributeMappings(d.Get("user_attribute_mapping").(*schema.Set)),
+		BypassLoginPage:            boolPtr(d.Get("bypass_login_page").(bool)),
+		AllowNormalGroupMembership: boolPtr(d.Get("allow_normal_group_membership").(bool)),
+		AllowRolesFromNormalGroups: boolPtr(d.Get("allow_roles_from_normal_groups").(bool)),
+		AllowDirectRoles:           boolPtr(d.Get("allow_direct_roles").(bool)),
+	}
+	if v, ok := d.GetOk("allowed_clock_drift"); ok {
+		config.AllowedClockDrift = castToPtr(int64(v.(int)))
+	}
+	return config
+}
+
+// testSamlConfig creates a test configuration, which Looker validates, then deletes it.
+func testSamlConfig(ctx context.Context, c *lookergo.Client, config *lookergo.SamlConfig) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "Testing Looker SAML config")
+	test, _, err := c.SamlConfig.CreateTestConfig(ctx, config)
+	if err != nil {
+		return diagErrAppend(diags, err)
+	}
+	if slug := valueFromPtr(test.TestSlug); slug != "" {
+		if _, err := c.SamlConfig.DeleteTestConfig(ctx, slug); err != nil {
+			tflog.Warn(ctx, "Could not delete Looker SAML test config", map[string]interface{}{"test_slug": slug, "error": err.Error()})
+		}
+	}
+	return diags
+}
+
+func applySamlConfig(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+
+	config := expandSamlConfig(d)
+	if d.Get("test_config").(bool) {
+		if diags = testSamlConfig(ctx, c, config); diags.HasError() {
+			return diags
+		}
+	}
+
+	tflog.Info(ctx, "Updating Looker SAML config")
+	if _, _, err := c.SamlConfig.Update(ctx, config); err != nil {
+		return diagErrAppend(diags, err)
+	}
+	d.SetId(samlConfigId)
+
+	return append(diags, resourceSamlConfigRead(ctx, d, m)...)
+}
+
+func resourceSamlConfigCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return applySamlConfig(ctx, d, m)
+}
+
+func resourceSamlConfigRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+
+	config, _, err := c.SamlConfig.Get(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return setAuthConfigAttributes(d, map[string]interface{}{
+		"enabled":                        valueFromPtr(config.Enabled),
+		"idp_url":                        valueFromPtr(config.IdpUrl),
+		"idp_issuer":                     valueFromPtr(config.IdpIssuer),
+		"idp_cert":                       valueFromPtr(config.IdpCert),
+		"idp_audience":                   valueFromPtr(config.IdpAudience),
+		"allowed_clock_drift":            int(valueFromPtr(config.AllowedClockDrift)),
+		"user_attribute_map_email":       valueFromPtr(config.UserAttributeMapEmail),
+		"user_attribute_map_first_name":  valueFromPtr(config.UserAttributeMapFirstName),
+		"user_attribute_map_last_name":   valueFromPtr(config.UserAttributeMapLastName),
+		"new_user_migration_types":       flattenNewUserMigrationTypes(config.NewUserMigrationTypes),
+		"alternate_email_login_allowed":  valueFromPtr(config.AlternateEmailLoginAllowed),
+		"default_new_user_role_ids":      flattenRoleIds(config.DefaultNewUserRoles),
+		"default_new_user_group_ids":     flattenGroupIds(config.DefaultNewUserGroups),
+		"set_roles_from_groups":          valueFromPtr(config.SetRolesFromGroups),
+		"groups_finder_type":             valueFromPtr(config.GroupsFinderType),
+		"groups_attribute":               valueFromPtr(config.GroupsAttribute),
+		"groups_member_value":            valueFromPtr(config.GroupsMemberValue),
+		"group_mapping":                  flattenAuthGroupMappings(config.GroupsWithRoleIds, d.Get("group_mapping").([]interface{})),
+		"auth_requires_role":             valueFromPtr(config.AuthRequiresRole),
+		"user_attribute_mapping":         flattenAuthUserAttributeMappings(config.UserAttributesWithIds),
+		"bypass_login_page":              valueFromPtr(config.BypassLoginPage),
+		"allow_normal_group_membership":  valueFromPtr(config.AllowNormalGroupMembership),
+		"allow_roles_from_normal_groups": valueFromPtr(config.AllowRolesFromNormalGroups),
+		"allow_direct_roles":             valueFromPtr(config.AllowDirectRoles),
+		"modified_at":                    valueFromPtr(config.ModifiedAt),
+		"modified_by":                    valueFromPtr(config.ModifiedBy),
+	})
+}
+
+func resourceSamlConfigUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return applySamlConfig(ctx, d, m)
+}
+
+func resourceSamlConfigDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+
+	tflog.Info(ctx, "Disabling Looker SAML authentication")
+	if _, _, err := c.SamlConfig.Update(ctx, &lookergo.SamlConfig{Enabled: boolPtr(false)}); err != nil {
+		return diag.FromErr(err)
+	}
+	// Finally mark as deleted
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,494 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/devoteamgcloud/terraform-provider-looker/pkg/lookergo"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var (
+	scheduledPlanContentKey = []string{
+		"look_id",
+		"dashboard_id",
+		"lookml_dashboard_id",
+	}
+	scheduledPlanTriggerKey = []string{
+		"crontab",
+		"datagroup",
+	}
+)
+
+func resourceScheduledPlan() *schema.Resource {
+	return &schema.Resource{
+		Description: `Manages a scheduled plan, which delivers the results of a look or dashboard to one or more destinations
+on a schedule or when a datagroup is triggered.
+
+Secret destination parameters (` + "`s3_secret_access_key`, `sftp_password`" + `) are write-only in Looker, so changes
+made to them outside of Terraform cannot be detected.
+`,
+		CreateContext: resourceScheduledPlanCreate,
+		ReadContext:   resourceScheduledPlanRead,
+		UpdateContext: resourceScheduledPlanUpdate,
+		DeleteContext: resourceScheduledPlanDelete,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the scheduled plan",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+				Description:  "Name of the scheduled plan",
+			},
+			"look_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: scheduledPlanContentKey,
+				Description:  "ID of the look to deliver",
+			},
+			"dashboard_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: scheduledPlanContentKey,
+				Description:  "ID of the dashboard to deliver",
+			},
+			"lookml_dashboard_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: scheduledPlanContentKey,
+				Description:  "ID of the LookML dashboard to deliver, e.g. `model::dashboard_name`",
+			},
+			"filters_string": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Query string to run the look or dashboard with, e.g. `?Created+Date=30+days`",
+			},
+			"crontab": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: scheduledPlanTriggerKey,
+				ValidateFunc: validateCrontab,
+				Description:  "Vixie-Style crontab specification when to run",
+			},
+			"datagroup": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: scheduledPlanTriggerKey,
+				Description:  "Name of a datagroup; the plan runs whenever the datagroup is triggered",
+			},
+			"timezone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Timezone for interpreting the crontab. Defaults to the Looker instance timezone.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the scheduled plan is enabled",
+			},
+			"run_as_recipient": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Run the schedule as each recipient, applying their permissions and user attributes. Only valid when all destinations are emails to Looker users.",
+			},
+			"require_results": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Only deliver if the look or dashboard returns results",
+			},
+			"require_no_results": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Only deliver if the look or dashboard does not return results",
+			},
+			"require_change": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Only deliver if the results have changed since the last run",
+			},
+			"send_all_results": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Run an unlimited query and send all results",
+			},
+			"include_links": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Include links back to Looker",
+			},
+			"pdf_paper_size": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"letter", "legal", "tabloid", "a0", "a1", "a2", "a3", "a4", "a5"}, false),
+				Description:  `The size of paper the PDF should be formatted to fit. Valid values are: "letter", "legal", "tabloid", "a0", "a1", "a2", "a3", "a4", "a5".`,
+			},
+			"pdf_landscape": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Format the PDF in landscape orientation",
+			},
+			"long_tables": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Expand table visualizations to their full length",
+			},
+			"destination": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "Destinations the results are delivered to",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the destination",
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								lookergo.ScheduledPlanDestinationType_EMAIL,
+								lookergo.ScheduledPlanDestinationType_WEBHOOK,
+								lookergo.ScheduledPlanDestinationType_S3,
+								lookergo.ScheduledPlanDestinationType_SFTP,
+							}, false),
+							Description: `Type of the destination. Valid values are: "email", "webhook", "s3", "sftp".`,
+						},
+						"address": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Address of the destination, e.g. `user@example.com`, `https://example.com/hook`, `s3://bucket/path/` or `sftp://host/path/`.",
+						},
+						"format": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"txt", "csv", "csv_zip", "inline_json", "json", "json_label", "json_detail", "json_detail_lite_stream",
+								"xlsx", "html", "wysiwyg_pdf", "assembled_pdf", "wysiwyg_png",
+							}, false),
+							Description: `Data format to deliver, e.g. "csv", "inline_json", "xlsx", "wysiwyg_pdf". Supported formats vary by destination type.`,
+						},
+						"apply_formatting": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Apply value formatting, e.g. currency symbols and digit separators",
+						},
+						"apply_vis": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Apply visualization options to the results",
+						},
+						"message": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Message included in scheduled emails",
+						},
+						"s3_region": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "AWS region of the bucket. Required for `s3` destinations.",
+						},
+						"s3_access_key_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "AWS access key ID. Required for `s3` destinations.",
+						},
+						"s3_secret_access_key": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+							Description: "AWS secret access key. Required for `s3` destinations.",
+						},
+						"sftp_username": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "SFTP username. Required for `sftp` destinations.",
+						},
+						"sftp_password": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+							Description: "SFTP password. Required for `sftp` destinations.",
+						},
+						"looker_recipient": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the email recipient is a Looker user",
+						},
+					},
+				},
+			},
+			"user_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the user who owns the scheduled plan",
+			},
+			"title": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Title of the delivered look or dashboard",
+			},
+			"next_run_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When the scheduled plan will next run",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func expandScheduledPlanDestinations(rawDestinations []interface{}) ([]lookergo.ScheduledPlanDestination, error) {
+	destinations := make([]lookergo.ScheduledPlanDestination, len(rawDestinations))
+	for i, elem := range rawDestinations {
+		raw := elem.(map[string]interface{})
+		destination := lookergo.ScheduledPlanDestination{
+			Type:            raw["type"].(string),
+			Address:         raw["address"].(string),
+			Format:          raw["format"].(string),
+			ApplyFormatting: boolPtr(raw["apply_formatting"].(bool)),
+			ApplyVis:        boolPtr(raw["apply_vis"].(bool)),
+		}
+		if value := raw["message"].(string); value != "" {
+			destination.Message = castToPtr(value)
+		}
+
+		var params, secretParams map[string]string
+		switch destination.Type {
+		case lookergo.ScheduledPlanDestinationType_S3:
+			params = map[string]string{"region": raw["s3_region"].(string), "access_key_id": raw["s3_access_key_id"].(string)}
+			secretParams = map[string]string{"secret_access_key": raw["s3_secret_access_key"].(string)}
+		case lookergo.ScheduledPlanDestinationType_SFTP:
+			params = map[string]string{"username": raw["sftp_username"].(string)}
+			secretParams = map[string]string{"password": raw["sftp_password"].(string)}
+		}
+		for _, p := range []map[string]string{params, secretParams} {
+			for key, value := range p {
+				if value == "" {
+					return nil, fmt.Errorf("destination %d: %s destinations require %s_%s", i, destination.Type, destination.Type, key)
+				}
+			}
+		}
+		if params != nil {
+			b, err := json.Marshal(params)
+			if err != nil {
+				return nil, err
+			}
+			destination.Parameters = castToPtr(string(b))
+		}
+		if secretParams != nil {
+			b, err := json.Marshal(secretParams)
+			if err != nil {
+				return nil, err
+			}
+			destination.SecretParameters = castToPtr(string(b))
+		}
+
+		destinations[i] = destination
+	}
+	return destinations, nil
+}
+
+// flattenScheduledPlanDestinations flattens the destinations returned by the API. Secret parameters are never returned,
+// so they are kept from the prior state of the destination at the same position.
+func flattenScheduledPlanDestinations(destinations []lookergo.ScheduledPlanDestination, prior []interface{}) []interface{} {
+	result := make([]interface{}, len(destinations))
+	for i, destination := range destinations {
+		params := map[string]string{}
+		if destination.Parameters != nil {
+			_ = json.Unmarshal([]byte(*destination.Parameters), &params)
+		}
+		raw := map[string]interface{}{
+			"id":                   destination.Id,
+			"type":                 destination.Type,
+			"address":              destination.Address,
+			"format":               destination.Format,
+			"apply_formatting":     valueFromPtr(destination.ApplyFormatting),
+			"apply_vis":            valueFromPtr(destination.ApplyVis),
+			"message":              valueFromPtr(destination.Message),
+			"s3_region":            "",
+			"s3_access_key_id":     "",
+			"s3_secret_access_key": "",
+			"sftp_username":        "",
+			"sftp_password":        "",
+			"looker_recipient":     destination.LookerRecipient,
+		}
+		switch destination.Type {
+		case lookergo.ScheduledPlanDestinationType_S3:
+			raw["s3_region"] = params["region"]
+			raw["s3_access_key_id"] = params["access_key_id"]
+		case lookergo.ScheduledPlanDestinationType_SFTP:
+			raw["sftp_username"] = params["username"]
+		}
+		if i < len(prior) {
+			if priorRaw, ok := prior[i].(map[string]interface{}); ok && priorRaw["type"] == destination.Type {
+				raw["s3_secret_access_key"] = priorRaw["s3_secret_access_key"]
+				raw["sftp_password"] = priorRaw["sftp_password"]
+			}
+		}
+		result[i] = raw
+	}
+	return result
+}
+
+func expandScheduledPlan(d *schema.ResourceData) (*lookergo.ScheduledPlan, error) {
+	destinations, err := expandScheduledPlanDestinations(d.Get("destination").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+
+	runAsRecipient := d.Get("run_as_recipient").(bool)
+	if runAsRecipient {
+		for _, destination := range destinations {
+			if destination.Type != lookergo.ScheduledPlanDestinationType_EMAIL {
+				return nil, fmt.Errorf("run_as_recipient is only supported when all destinations are of type %q", lookergo.ScheduledPlanDestinationType_EMAIL)
+			}
+		}
+	}
+
+	plan := &lookergo.ScheduledPlan{
+		Name:                     d.Get("name").(string),
+		LookId:                   d.Get("look_id").(string),
+		DashboardId:              d.Get("dashboard_id").(string),
+		LookmlDashboardId:        d.Get("lookml_dashboard_id").(string),
+		FiltersString:            castToPtr(d.Get("filters_string").(string)),
+		Enabled:                  boolPtr(d.Get("enabled").(bool)),
+		RunAsRecipient:           boolPtr(runAsRecipient),
+		RequireResults:           boolPtr(d.Get("require_results").(bool)),
+		RequireNoResults:         boolPtr(d.Get("require_no_results").(bool)),
+		RequireChange:            boolPtr(d.Get("require_change").(bool)),
+		SendAllResults:           boolPtr(d.Get("send_all_results").(bool)),
+		IncludeLinks:             boolPtr(d.Get("include_links").(bool)),
+		PdfLandscape:             boolPtr(d.Get("pdf_landscape").(bool)),
+		LongTables:               boolPtr(d.Get("long_tables").(bool)),
+		ScheduledPlanDestination: destinations,
+	}
+	if value, ok := d.GetOk("crontab"); ok {
+		plan.Crontab = castToPtr(value.(string))
+	}
+	if value, ok := d.GetOk("datagroup"); ok {
+		plan.Datagroup = castToPtr(value.(string))
+	}
+	if value, ok := d.GetOk("timezone"); ok {
+		plan.Timezone = castToPtr(value.(string))
+	}
+	if value, ok := d.GetOk("pdf_paper_size"); ok {
+		plan.PdfPaperSize = castToPtr(value.(string))
+	}
+	return plan, nil
+}
+
+func resourceScheduledPlanCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+
+	plan, err := expandScheduledPlan(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, "Creating Looker scheduled plan")
+	newPlan, _, err := c.ScheduledPlans.Create(ctx, plan)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(newPlan.Id)
+
+	tflog.Info(ctx, "Created Looker scheduled plan", map[string]interface{}{"id": newPlan.Id, "name": newPlan.Name})
+
+	return resourceScheduledPlanRead(ctx, d, m)
+}
+
+func resourceScheduledPlanRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+
+	plan, _, err := c.ScheduledPlans.Get(ctx, d.Id())
+	if lookergo.IsNotFound(err) {
+		d.SetId("") // Mark as deleted
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("name", plan.Name)
+	d.Set("look_id", plan.LookId)
+	d.Set("dashboard_id", plan.DashboardId)
+	d.Set("lookml_dashboard_id", plan.LookmlDashboardId)
+	d.Set("filters_string", valueFromPtr(plan.FiltersString))
+	d.Set("crontab", valueFromPtr(plan.Crontab))
+	d.Set("datagroup", valueFromPtr(plan.Datagroup))
+	d.Set("timezone", valueFromPtr(plan.Timezone))
+	d.Set("enabled", valueFromPtr(plan.Enabled))
+	d.Set("run_as_recipient", valueFromPtr(plan.RunAsRecipient))
+	d.Set("require_results", valueFromPtr(plan.RequireResults))
+	d.Set("require_no_results", valueFromPtr(plan.RequireNoResults))
+	d.Set("require_change", valueFromPtr(plan.RequireChange))
+	d.Set("send_all_results", valueFromPtr(plan.SendAllResults))
+	d.Set("include_links", valueFromPtr(plan.IncludeLinks))
+	d.Set("pdf_paper_size", valueFromPtr(plan.PdfPaperSize))
+	d.Set("pdf_landscape", valueFromPtr(plan.PdfLandscape))
+	d.Set("long_tables", valueFromPtr(plan.LongTables))
+	d.Set("user_id", plan.UserId)
+	d.Set("title", plan.Title)
+	if plan.NextRunAt != nil {
+		d.Set("next_run_at", plan.NextRunAt.String())
+	} else {
+		d.Set("next_run_at", "")
+	}
+	destinations := flattenScheduledPlanDestinations(plan.ScheduledPlanDestination, d.Get("destination").([]interface{}))
+	if err = d.Set("destination", destinations); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceScheduledPlanUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+
+	plan, err := expandScheduledPlan(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, "Updating Looker scheduled plan", map[string]interface{}{"id": d.Id()})
+	if _, _, err = c.ScheduledPlans.Update(ctx, d.Id(), plan); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceScheduledPlanRead(ctx, d, m)
+}
+
+func resourceScheduledPlanDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+
+	if _, err := c.ScheduledPlans.Delete(ctx, d.Id()); err != nil && !lookergo.IsNotFound(err) {
+		return diag.FromErr(err)
+	}
+	// Finally mark as deleted
+	d.SetId("")
+
+	return diags
+}
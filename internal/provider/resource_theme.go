@@ -497,6 +497,10 @@ func resourceThemeRead(ctx context.Context, d *schema.ResourceData, m interface{
 	c := m.(*Config).Api // .(*lookergo.Client)
 	id := d.Id()
 	theme, _, err := c.Themes.Get(ctx, id)
+	if lookergo.IsNotFound(err) {
+		d.SetId("") // Mark as deleted
+		return diags
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
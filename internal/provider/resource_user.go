@@ -22,7 +22,7 @@ var (
 
 // -
 func resourceUser() *schema.Resource {
-	return &schema.Resource{
+	resource := &schema.Resource{
 		CreateContext: resourceUserCreate,
 		ReadContext:   resourceUserRead,
 		UpdateContext: resourceUserUpdate,
@@ -80,12 +80,46 @@ func resourceUser() *schema.Resource {
 				Optional:    true,
 				Description: "This will send a password reset email to the user. If a password reset token does not already exist for this user, it will create one and then send it. If the user has not yet set up their account, it will send a setup email to the user.",
 			},
+			"unlink_credentials": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(unlinkableUserCredentials, false),
+				},
+				Description: "Types of credentials to unlink from the user, e.g. when offboarding someone: " + strings.Join(unlinkableUserCredentials, ", ") + ". Credentials linked again, e.g. by logging in with SSO, are unlinked on the next apply.",
+			},
+			"totp_reset_trigger": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Any value. Changing it resets the two-factor authentication of the user, who has to set it up again on the next login.",
+			},
 		},
 		Importer: &schema.ResourceImporter{
 			// State: schema.ImportStatePassthrough,
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 	}
+	for key, credentialsSchema := range userCredentialsSchema() {
+		resource.Schema[key] = credentialsSchema
+	}
+	return resource
+}
+
+// setUserCredentialsState sets the credentials of the user, and removes the credentials that are linked again from
+// unlink_credentials so that they are unlinked on the next apply.
+func setUserCredentialsState(d *schema.ResourceData, user *lookergo.User) error {
+	if err := setUserCredentials(d, user); err != nil {
+		return err
+	}
+	linked := linkedUserCredentials(user)
+	unlinked := []string{}
+	for _, credentialsType := range schemaSetToStringSlice(d.Get("unlink_credentials").(*schema.Set)) {
+		if !linked[credentialsType] {
+			unlinked = append(unlinked, credentialsType)
+		}
+	}
+	return d.Set("unlink_credentials", unlinked)
 }
 
 func checkUserAlreadyExists(ctx context.Context, d *schema.ResourceData, c *lookergo.Client, email string) (lookergo.User, error) {
@@ -124,6 +158,9 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, m interface
 			}
 			if user.Id != "" {
 				d.SetId(user.Id)
+				if err = unlinkUserCredentials(ctx, c, user.Id, schemaSetToStringSlice(d.Get("unlink_credentials").(*schema.Set))); err != nil {
+					return diag.FromErr(err)
+				}
 				resourceUserRead(ctx, d, m)
 				return diags
 			}
@@ -190,6 +227,10 @@ func resourceUserRead(ctx context.Context, d *schema.ResourceData, m interface{}
 	}
 	if d.Get("already_exists_ok") == true {
 		user, _, err := c.Users.Get(ctx, d.Id())
+		if lookergo.IsNotFound(err) {
+			d.SetId("") // Mark as deleted
+			return diags
+		}
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -203,12 +244,15 @@ func resourceUserRead(ctx context.Context, d *schema.ResourceData, m interface{}
 		d.Set("first_name", user.FirstName)
 		d.Set("last_name", user.LastName)
 		d.Set("roles", user.RoleIds.ToSliceOfStrings())
+		if err = setUserCredentialsState(d, user); err != nil {
+			return diag.FromErr(err)
+		}
 		return diags
 	}
 	userID := d.Id()
 
-	user, response, err := c.Users.Get(ctx, userID)
-	if response.StatusCode == 404 {
+	user, _, err := c.Users.Get(ctx, userID)
+	if lookergo.IsNotFound(err) {
 		d.SetId("") // Mark as deleted
 		return diags
 	}
@@ -231,6 +275,9 @@ func resourceUserRead(ctx context.Context, d *schema.ResourceData, m interface{}
 			return diag.FromErr(err)
 		}
 	}
+	if err = setUserCredentialsState(d, user); err != nil {
+		return diag.FromErr(err)
+	}
 
 	email, _, err := c.Users.GetEmail(ctx, userID)
 	if err != nil {
@@ -291,6 +338,19 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, m interface
 		}
 	}
 
+	if d.HasChange("unlink_credentials") {
+		if err = unlinkUserCredentials(ctx, c, userID, schemaSetToStringSlice(d.Get("unlink_credentials").(*schema.Set))); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("totp_reset_trigger") {
+		tflog.Info(ctx, "Resetting Looker user two-factor authentication", map[string]interface{}{"user_id": userID})
+		if _, err = c.Users.DeleteTotp(ctx, userID); err != nil && !lookergo.IsNotFound(err) {
+			return diag.FromErr(err)
+		}
+	}
+
 	d.Set("last_updated", time.Now().Format(time.RFC850))
 
 	return resourceUserRead(ctx, d, m)
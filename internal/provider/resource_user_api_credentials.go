@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/devoteamgcloud/terraform-provider-looker/pkg/lookergo"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceUserApiCredentials() *schema.Resource {
+	return &schema.Resource{
+		Description: `Creates API3 credentials (a client ID and secret) for a user, e.g. a service account used by CI.
+
+The client secret is only returned by Looker when the credentials are created: it is stored in the Terraform state, which must be protected accordingly. It is empty for imported credentials.
+A user can have several credentials, so new credentials can be created before the old ones are destroyed to rotate them.
+`,
+		CreateContext: resourceUserApiCredentialsCreate,
+		ReadContext:   resourceUserApiCredentialsRead,
+		DeleteContext: resourceUserApiCredentialsDelete,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the credentials, in the format `user_id:credentials_id`",
+			},
+			"user_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the user",
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Client ID to log in to the API with",
+			},
+			"client_secret": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Client secret to log in to the API with",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When the credentials were created",
+			},
+			"is_disabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the credentials are disabled",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				userId, _, err := parseTwoPartId(d.Id(), "user_id", "credentials_id")
+				if err != nil {
+					return nil, err
+				}
+				d.Set("user_id", userId)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+	}
+}
+
+func resourceUserApiCredentialsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+
+	userId := d.Get("user_id").(string)
+	tflog.Info(ctx, "Creating Looker API credentials", map[string]interface{}{"user_id": userId})
+	credentials, _, err := c.Users.CreateApi3(ctx, userId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(buildTwoPartId(userId, credentials.Id))
+	// The secret can't be read afterwards.
+	d.Set("client_secret", credentials.ClientSecret)
+
+	return resourceUserApiCredentialsRead(ctx, d, m)
+}
+
+func resourceUserApiCredentialsRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+
+	userId, credentialsId, err := parseTwoPartId(d.Id(), "user_id", "credentials_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	credentials, _, err := c.Users.GetApi3(ctx, userId, credentialsId)
+	if lookergo.IsNotFound(err) {
+		d.SetId("") // Mark as deleted
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("user_id", userId)
+	d.Set("client_id", credentials.ClientId)
+	d.Set("created_at", credentials.CreatedAt)
+	d.Set("is_disabled", credentials.IsDisabled)
+
+	return diags
+}
+
+func resourceUserApiCredentialsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+
+	userId, credentialsId, err := parseTwoPartId(d.Id(), "user_id", "credentials_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Info(ctx, "Deleting Looker API credentials", map[string]interface{}{"user_id": userId, "credentials_id": credentialsId})
+	if _, err = c.Users.DeleteApi3(ctx, userId, credentialsId); err != nil && !lookergo.IsNotFound(err) {
+		return diag.FromErr(err)
+	}
+	// Finally mark as deleted
+	d.SetId("")
+
+	return diags
+}
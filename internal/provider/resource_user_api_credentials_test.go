@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceUserApiCredentials(t *testing.T) {
+	mux, config := setupMockServer(t)
+	mux.HandleFunc("/api/4.0/users/60/credentials_api3", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+		}
+		fmt.Fprint(w, `{"id":"7","client_id":"abc","client_secret":"s3cr3t","created_at":"2024-03-12T09:41:27.000+00:00"}`)
+	})
+	deleted := false
+	mux.HandleFunc("/api/4.0/users/60/credentials_api3/7", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			if deleted {
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprint(w, `{"message":"Not found"}`)
+				return
+			}
+			fmt.Fprint(w, `{"id":"7","client_id":"abc","created_at":"2024-03-12T09:41:27.000+00:00"}`)
+		case http.MethodDelete:
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+		}
+	})
+	ctx := context.Background()
+	resource := resourceUserApiCredentials()
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{"user_id": "60"})
+	if diags := resource.CreateContext(ctx, d, config); diags.HasError() {
+		t.Fatalf("create returned error: %v", diags)
+	}
+	if d.Id() != "60:7" || d.Get("client_id") != "abc" || d.Get("client_secret") != "s3cr3t" {
+		t.Errorf("unexpected credentials: id %q, client_id %v, client_secret %v", d.Id(), d.Get("client_id"), d.Get("client_secret"))
+	}
+
+	// The secret is not returned anymore, but must be kept.
+	if diags := resource.ReadContext(ctx, d, config); diags.HasError() {
+		t.Fatalf("read returned error: %v", diags)
+	}
+	if d.Get("client_secret") != "s3cr3t" {
+		t.Errorf("client_secret = %v after read, expected s3cr3t", d.Get("client_secret"))
+	}
+
+	imported := importAndRead(t, resource, "60:7", config)
+	if imported.Get("user_id") != "60" || imported.Get("client_id") != "abc" {
+		t.Errorf("unexpected imported credentials: user_id %v, client_id %v", imported.Get("user_id"), imported.Get("client_id"))
+	}
+
+	if diags := resource.DeleteContext(ctx, d, config); diags.HasError() {
+		t.Fatalf("delete returned error: %v", diags)
+	}
+	if diags := resource.ReadContext(ctx, imported, config); diags.HasError() || imported.Id() != "" {
+		t.Errorf("expected deleted credentials to be removed from the state, got id %q, %v", imported.Id(), diags)
+	}
+}
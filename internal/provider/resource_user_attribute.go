@@ -134,6 +134,10 @@ func resourceUserAttributeRead(ctx context.Context, d *schema.ResourceData, m in
 	c := m.(*Config).Api // .(*lookergo.Client)
 	var diags diag.Diagnostics
 	UserAttr, _, err := c.UserAttributes.Get(ctx, idAsInt(d.Get("id")))
+	if lookergo.IsNotFound(err) {
+		d.SetId("") // Mark as deleted
+		return diags
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -78,6 +78,10 @@ func resourceUserAttributeMemberRead(ctx context.Context, d *schema.ResourceData
 	var diags diag.Diagnostics
 	ID := d.Get("user_attribute_id").(string)
 	attrs, _, err := c.UserAttributes.GetUserAttributeValue(ctx, ID)
+	if lookergo.IsNotFound(err) {
+		d.SetId("") // Mark as deleted
+		return diags
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// setupMockUserCredentials serves user 60, whose SAML identity is linked while *samlLinked is true and whose
+// credentials can be deleted. Deleted credentials are recorded in deleted.
+func setupMockUserCredentials(t *testing.T, samlLinked *bool, deleted *[]string) *Config {
+	mux, config := setupMockServer(t)
+	mux.HandleFunc("/api/4.0/users/60", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodPatch {
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+		}
+		saml := ""
+		if *samlLinked {
+			saml = `"credentials_saml":{"email":"jane@example.com","saml_user_id":"jane"},`
+		}
+		fmt.Fprintf(w, `{"id":"60","first_name":"Jane",%s"credentials_totp":{"verified":true},"credentials_api3":[{"id":"7","client_id":"abc"}]}`, saml)
+	})
+	mux.HandleFunc("/api/4.0/users/60/", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodDelete:
+			*deleted = append(*deleted, r.URL.Path)
+			if r.URL.Path == "/api/4.0/users/60/credentials_saml" {
+				*samlLinked = false
+			}
+			w.WriteHeader(http.StatusNoContent)
+		case r.URL.Path == "/api/4.0/users/60/credentials_email":
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"message":"Not found"}`)
+		default:
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+		}
+	})
+	return config
+}
+
+func TestResourceUser_Credentials(t *testing.T) {
+	samlLinked := true
+	var deleted []string
+	config := setupMockUserCredentials(t, &samlLinked, &deleted)
+	ctx := context.Background()
+	resource := resourceUser()
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"first_name":         "Jane",
+		"unlink_credentials": []interface{}{"saml"},
+		"totp_reset_trigger": "2024-03-12",
+	})
+	d.SetId("60")
+	if diags := resource.UpdateContext(ctx, d, config); diags.HasError() {
+		t.Fatalf("update returned error: %v", diags)
+	}
+	if want := []string{"/api/4.0/users/60/credentials_saml", "/api/4.0/users/60/credentials_totp"}; !reflect.DeepEqual(deleted, want) {
+		t.Errorf("deleted credentials = %v, expected %v", deleted, want)
+	}
+	if got := schemaSetToStringSlice(d.Get("unlink_credentials").(*schema.Set)); !reflect.DeepEqual(got, []string{"saml"}) {
+		t.Errorf("unlink_credentials = %v, expected [saml]", got)
+	}
+	if d.Get("credentials_saml.#") != 0 || d.Get("credentials_api3.0.client_id") != "abc" || d.Get("credentials_totp.0.verified") != true {
+		t.Errorf("unexpected credentials: saml %v, api3 %v, totp %v", d.Get("credentials_saml"), d.Get("credentials_api3"), d.Get("credentials_totp"))
+	}
+
+	// The user logs in with SAML again: the identity must be unlinked again on the next apply.
+	samlLinked = true
+	if diags := resource.ReadContext(ctx, d, config); diags.HasError() {
+		t.Fatalf("read returned error: %v", diags)
+	}
+	if got := d.Get("unlink_credentials").(*schema.Set).Len(); got != 0 {
+		t.Errorf("unlink_credentials has %d elements after the identity was linked again, expected 0", got)
+	}
+	if d.Get("credentials_saml.0.saml_user_id") != "jane" {
+		t.Errorf("credentials_saml = %v, expected the linked identity", d.Get("credentials_saml"))
+	}
+}
+
+func TestDataSourceUser_Credentials(t *testing.T) {
+	samlLinked := true
+	config := setupMockUserCredentials(t, &samlLinked, new([]string))
+	dataSource := dataSourceUser()
+
+	d := schema.TestResourceDataRaw(t, dataSource.Schema, map[string]interface{}{"id": "60"})
+	if diags := dataSource.ReadContext(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("read returned error: %v", diags)
+	}
+	if d.Get("credentials_saml.0.email") != "jane@example.com" || d.Get("credentials_google.#") != 0 {
+		t.Errorf("unexpected credentials: saml %v, google %v", d.Get("credentials_saml"), d.Get("credentials_google"))
+	}
+}
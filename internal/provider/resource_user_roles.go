@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/devoteamgcloud/terraform-provider-looker/pkg/lookergo"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceUserRoles() *schema.Resource {
+	return &schema.Resource{
+		Description: `Manages the roles assigned directly to a user, e.g. a service account that is not created by Terraform.
+
+This resource is authoritative: roles assigned directly to the user that are not declared are removed. Roles the user gets through a group are not affected.
+Do not combine it with the ` + "`roles`" + ` argument of ` + "`looker_user`" + ` or with ` + "`looker_role_users`" + ` for the same user.
+`,
+		CreateContext: resourceUserRolesCreate,
+		ReadContext:   resourceUserRolesRead,
+		UpdateContext: resourceUserRolesUpdate,
+		DeleteContext: resourceUserRolesDelete,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the user",
+			},
+			"user_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the user",
+			},
+			"role_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the roles assigned to the user. Leave empty to remove all roles assigned directly to the user.",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				d.Set("user_id", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+	}
+}
+
+func resourceUserRolesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+	userId := d.Get("user_id").(string)
+	roleIds := schemaSetToStringSlice(d.Get("role_ids").(*schema.Set))
+
+	tflog.Info(ctx, "Setting Looker user roles", map[string]interface{}{"user_id": userId, "role_ids": roleIds})
+	if _, _, err := c.Users.SetRoles(ctx, userId, roleIds); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(userId)
+
+	return resourceUserRolesRead(ctx, d, m)
+}
+
+func resourceUserRolesRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+
+	roles, _, err := c.Users.GetDirectRoles(ctx, d.Id())
+	if lookergo.IsNotFound(err) {
+		d.SetId("") // Mark as deleted
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	roleIds := make([]string, len(roles))
+	for i, role := range roles {
+		roleIds[i] = idAsString(role.Id)
+	}
+	d.Set("user_id", d.Id())
+	if err = d.Set("role_ids", roleIds); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceUserRolesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+	roleIds := schemaSetToStringSlice(d.Get("role_ids").(*schema.Set))
+
+	tflog.Info(ctx, "Updating Looker user roles", map[string]interface{}{"user_id": d.Id(), "role_ids": roleIds})
+	if _, _, err := c.Users.SetRoles(ctx, d.Id(), roleIds); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceUserRolesRead(ctx, d, m)
+}
+
+func resourceUserRolesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	c := m.(*Config).Api // .(*lookergo.Client)
+
+	tflog.Info(ctx, "Removing all Looker user roles", map[string]interface{}{"user_id": d.Id()})
+	if _, _, err := c.Users.SetRoles(ctx, d.Id(), []string{}); err != nil && !lookergo.IsNotFound(err) {
+		return diag.FromErr(err)
+	}
+	// Finally mark as deleted
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// setupMockAssignment serves a list of directly assigned ids at path, which can be replaced with a PUT request.
+func setupMockAssignment(t *testing.T, path string, ids *[]string) *Config {
+	mux, config := setupMockServer(t)
+	mux.HandleFunc("/api/"+path, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			if r.URL.Query().Get("direct_association_only") != "true" {
+				t.Errorf("expected only direct associations to be requested, got %s", r.URL.RawQuery)
+			}
+		case http.MethodPut:
+			*ids = nil
+			if err := json.NewDecoder(r.Body).Decode(ids); err != nil {
+				t.Fatal(err)
+			}
+		default:
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+		}
+		items := make([]string, len(*ids))
+		for i, id := range *ids {
+			items[i] = fmt.Sprintf(`{"id":"%s"}`, id)
+		}
+		fmt.Fprintf(w, "[%s]", strings.Join(items, ","))
+	})
+	return config
+}
+
+func stringSetValue(d *schema.ResourceData, key string) []string {
+	values := schemaSetToStringSlice(d.Get(key).(*schema.Set))
+	sort.Strings(values)
+	return values
+}
+
+func TestResourceUserRoles(t *testing.T) {
+	roleIds := []string{"2"}
+	config := setupMockAssignment(t, "4.0/users/60/roles", &roleIds)
+	ctx := context.Background()
+	resource := resourceUserRoles()
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"user_id":  "60",
+		"role_ids": []interface{}{"9", "4"},
+	})
+	if diags := resource.CreateContext(ctx, d, config); diags.HasError() {
+		t.Fatalf("create returned error: %v", diags)
+	}
+	if d.Id() != "60" {
+		t.Errorf("id = %q, expected 60", d.Id())
+	}
+	sort.Strings(roleIds)
+	if want := []string{"4", "9"}; !reflect.DeepEqual(roleIds, want) {
+		t.Errorf("roles of the user = %v, expected %v", roleIds, want)
+	}
+
+	// A role is assigned outside of Terraform.
+	roleIds = append(roleIds, "5")
+	if diags := resource.ReadContext(ctx, d, config); diags.HasError() {
+		t.Fatalf("read returned error: %v", diags)
+	}
+	if got, want := stringSetValue(d, "role_ids"), []string{"4", "5", "9"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("role_ids = %v, expected %v", got, want)
+	}
+
+	imported := importAndRead(t, resource, "60", config)
+	if imported.Get("user_id") != "60" {
+		t.Errorf("imported user_id = %v, expected 60", imported.Get("user_id"))
+	}
+	if got, want := stringSetValue(imported, "role_ids"), []string{"4", "5", "9"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("imported role_ids = %v, expected %v", got, want)
+	}
+
+	if diags := resource.DeleteContext(ctx, d, config); diags.HasError() {
+		t.Fatalf("delete returned error: %v", diags)
+	}
+	if len(roleIds) != 0 {
+		t.Errorf("roles of the user = %v, expected none", roleIds)
+	}
+}
+
+func TestResourceRoleUsers(t *testing.T) {
+	userIds := []string{"61"}
+	config := setupMockAssignment(t, "4.0/roles/9/users", &userIds)
+	ctx := context.Background()
+	resource := resourceRoleUsers()
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"role_id":  "9",
+		"user_ids": []interface{}{"60"},
+	})
+	if diags := resource.CreateContext(ctx, d, config); diags.HasError() {
+		t.Fatalf("create returned error: %v", diags)
+	}
+	if d.Id() != "9" {
+		t.Errorf("id = %q, expected 9", d.Id())
+	}
+	if want := []string{"60"}; !reflect.DeepEqual(userIds, want) {
+		t.Errorf("users of the role = %v, expected %v", userIds, want)
+	}
+
+	imported := importAndRead(t, resource, "9", config)
+	if got, want := stringSetValue(imported, "user_ids"), []string{"60"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("imported user_ids = %v, expected %v", got, want)
+	}
+
+	if diags := resource.DeleteContext(ctx, d, config); diags.HasError() {
+		t.Fatalf("delete returned error: %v", diags)
+	}
+	if len(userIds) != 0 {
+		t.Errorf("users of the role = %v, expected none", userIds)
+	}
+}
@@ -0,0 +1,266 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/devoteamgcloud/terraform-provider-looker/pkg/lookergo"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Computed credentials of looker_user and the looker_user data source, and unlinking of SSO identities.
+
+// Credential types that can be unlinked from a user with unlink_credentials.
+const (
+	userCredentialsGoogle       = "google"
+	userCredentialsLDAP         = "ldap"
+	userCredentialsOIDC         = "oidc"
+	userCredentialsSaml         = "saml"
+	userCredentialsLookerOpenid = "looker_openid"
+	userCredentialsEmbed        = "embed"
+)
+
+var unlinkableUserCredentials = []string{
+	userCredentialsGoogle,
+	userCredentialsLDAP,
+	userCredentialsOIDC,
+	userCredentialsSaml,
+	userCredentialsLookerOpenid,
+	userCredentialsEmbed,
+}
+
+// credentialsSchema returns a computed block of credentials, with the attributes common to all credentials and attrs.
+func credentialsSchema(description string, attrs map[string]string) *schema.Schema {
+	s := map[string]*schema.Schema{
+		"created_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "When the credentials were created",
+		},
+		"is_disabled": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the credentials are disabled",
+		},
+	}
+	for name, desc := range attrs {
+		s[name] = &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: desc,
+		}
+	}
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: description,
+		Elem:        &schema.Resource{Schema: s},
+	}
+}
+
+// userCredentialsSchema returns the computed credentials blocks of a user.
+func userCredentialsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"credentials_google": credentialsSchema("Google account linked to the user", map[string]string{
+			"email":          "Email of the account",
+			"domain":         "Google domain",
+			"google_user_id": "Google ID of the user",
+			"logged_in_at":   "Last login with the credentials",
+		}),
+		"credentials_ldap": credentialsSchema("LDAP record linked to the user", map[string]string{
+			"email":        "Email of the record",
+			"ldap_dn":      "Distinguished name of the record, as of the last login",
+			"ldap_id":      "LDAP ID of the user",
+			"logged_in_at": "Last login with the credentials",
+		}),
+		"credentials_oidc": credentialsSchema("OpenID Connect identity linked to the user", map[string]string{
+			"email":        "Email of the identity",
+			"oidc_user_id": "OpenID provider ID of the user",
+			"logged_in_at": "Last login with the credentials",
+		}),
+		"credentials_saml": credentialsSchema("SAML identity linked to the user", map[string]string{
+			"email":        "Email of the identity",
+			"saml_user_id": "Identity provider ID of the user",
+			"logged_in_at": "Last login with the credentials",
+		}),
+		"credentials_looker_openid": credentialsSchema("Looker employee OpenID account linked to the user", map[string]string{
+			"email":        "Email of the account",
+			"logged_in_at": "Last login with the credentials",
+			"logged_in_ip": "IP address of the last login with the credentials",
+		}),
+		"credentials_embed": credentialsSchema("Embed identities of the user", map[string]string{
+			"id":                "ID of the credentials",
+			"external_user_id":  "ID of the user in the embedding application",
+			"external_group_id": "ID of the group of the user in the embedding application, as of the last login",
+			"logged_in_at":      "Last login with the credentials",
+		}),
+		"credentials_api3": credentialsSchema("API credentials of the user", map[string]string{
+			"id":        "ID of the credentials",
+			"client_id": "Client ID",
+		}),
+		"credentials_totp": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Two-factor authentication of the user",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"created_at": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "When two-factor authentication was enabled",
+					},
+					"is_disabled": {
+						Type:        schema.TypeBool,
+						Computed:    true,
+						Description: "Whether the credentials are disabled",
+					},
+					"verified": {
+						Type:        schema.TypeBool,
+						Computed:    true,
+						Description: "Whether the user completed the setup of two-factor authentication",
+					},
+				},
+			},
+		},
+	}
+}
+
+// setUserCredentials sets the computed credentials blocks from user.
+func setUserCredentials(d *schema.ResourceData, user *lookergo.User) error {
+	credentials := map[string][]interface{}{}
+	if c := user.CredentialsGoogle; c != nil {
+		credentials["credentials_google"] = []interface{}{map[string]interface{}{
+			"created_at":     c.CreatedAt,
+			"is_disabled":    c.IsDisabled,
+			"email":          c.Email,
+			"domain":         c.Domain,
+			"google_user_id": c.GoogleUserId,
+			"logged_in_at":   c.LoggedInAt,
+		}}
+	}
+	if c := user.CredentialsLdap; c != nil {
+		credentials["credentials_ldap"] = []interface{}{map[string]interface{}{
+			"created_at":   c.CreatedAt,
+			"is_disabled":  c.IsDisabled,
+			"email":        c.Email,
+			"ldap_dn":      c.LdapDn,
+			"ldap_id":      c.LdapId,
+			"logged_in_at": c.LoggedInAt,
+		}}
+	}
+	if c := user.CredentialsOidc; c != nil {
+		credentials["credentials_oidc"] = []interface{}{map[string]interface{}{
+			"created_at":   c.CreatedAt,
+			"is_disabled":  c.IsDisabled,
+			"email":        c.Email,
+			"oidc_user_id": c.OidcUserId,
+			"logged_in_at": c.LoggedInAt,
+		}}
+	}
+	if c := user.CredentialsSaml; c != nil {
+		credentials["credentials_saml"] = []interface{}{map[string]interface{}{
+			"created_at":   c.CreatedAt,
+			"is_disabled":  c.IsDisabled,
+			"email":        c.Email,
+			"saml_user_id": c.SamlUserId,
+			"logged_in_at": c.LoggedInAt,
+		}}
+	}
+	if c := user.CredentialsLookerOpenid; c != nil {
+		credentials["credentials_looker_openid"] = []interface{}{map[string]interface{}{
+			"created_at":   c.CreatedAt,
+			"is_disabled":  c.IsDisabled,
+			"email":        c.Email,
+			"logged_in_at": c.LoggedInAt,
+			"logged_in_ip": c.LoggedInIp,
+		}}
+	}
+	if user.CredentialsEmbed != nil {
+		for _, c := range *user.CredentialsEmbed {
+			credentials["credentials_embed"] = append(credentials["credentials_embed"], map[string]interface{}{
+				"created_at":        c.CreatedAt,
+				"is_disabled":       c.IsDisabled,
+				"id":                c.Id,
+				"external_user_id":  c.ExternalUserId,
+				"external_group_id": c.ExternalGroupId,
+				"logged_in_at":      c.LoggedInAt,
+			})
+		}
+	}
+	if user.CredentialsApi3 != nil {
+		for _, c := range *user.CredentialsApi3 {
+			credentials["credentials_api3"] = append(credentials["credentials_api3"], map[string]interface{}{
+				"created_at":  c.CreatedAt,
+				"is_disabled": c.IsDisabled,
+				"id":          c.Id,
+				"client_id":   c.ClientId,
+			})
+		}
+	}
+	if c := user.CredentialsTotp; c != nil {
+		credentials["credentials_totp"] = []interface{}{map[string]interface{}{
+			"created_at":  c.CreatedAt,
+			"is_disabled": c.IsDisabled,
+			"verified":    c.Verified,
+		}}
+	}
+
+	for key := range userCredentialsSchema() {
+		if err := d.Set(key, credentials[key]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// linkedUserCredentials returns which of the unlinkable credential types the user has.
+func linkedUserCredentials(user *lookergo.User) map[string]bool {
+	return map[string]bool{
+		userCredentialsGoogle:       user.CredentialsGoogle != nil,
+		userCredentialsLDAP:         user.CredentialsLdap != nil,
+		userCredentialsOIDC:         user.CredentialsOidc != nil,
+		userCredentialsSaml:         user.CredentialsSaml != nil,
+		userCredentialsLookerOpenid: user.CredentialsLookerOpenid != nil,
+		userCredentialsEmbed:        user.CredentialsEmbed != nil && len(*user.CredentialsEmbed) > 0,
+	}
+}
+
+// unlinkUserCredentials deletes the credentials of the given types from the user. Credentials that do not exist are
+// ignored.
+func unlinkUserCredentials(ctx context.Context, c *lookergo.Client, userId string, types []string) error {
+	for _, credentialsType := range types {
+		tflog.Info(ctx, "Unlinking Looker user credentials", map[string]interface{}{"user_id": userId, "type": credentialsType})
+		var err error
+		switch credentialsType {
+		case userCredentialsGoogle:
+			_, err = c.Users.DeleteGoogle(ctx, userId)
+		case userCredentialsLDAP:
+			_, err = c.Users.DeleteLDAP(ctx, userId)
+		case userCredentialsOIDC:
+			_, err = c.Users.DeleteOIDC(ctx, userId)
+		case userCredentialsSaml:
+			_, err = c.Users.DeleteSaml(ctx, userId)
+		case userCredentialsLookerOpenid:
+			_, err = c.Users.DeleteLookerOpenid(ctx, userId)
+		case userCredentialsEmbed:
+			err = deleteUserEmbedCredentials(ctx, c, userId)
+		}
+		if err != nil && !lookergo.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func deleteUserEmbedCredentials(ctx context.Context, c *lookergo.Client, userId string) error {
+	embeds, _, err := c.Users.ListEmbed(ctx, userId)
+	if err != nil {
+		return err
+	}
+	for _, embed := range embeds {
+		if _, err = c.Users.DeleteEmbed(ctx, userId, embed.Id); err != nil && !lookergo.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
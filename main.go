@@ -1,6 +1,10 @@
 package main
 
 import (
+	"context"
+	"log"
+	"time"
+
 	"github.com/devoteamgcloud/terraform-provider-looker/internal/provider"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
@@ -29,4 +33,12 @@ func main() {
 
 		ProviderFunc: provider.New(version),
 	})
+
+	// Terraform is done with the plugin: log out of the Looker dev sessions. This is best-effort, as Terraform may kill
+	// the plugin before Serve returns; sessions which are not logged out expire on their own.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := provider.Shutdown(ctx); err != nil {
+		log.Printf("[WARN] Unable to log out of Looker dev session: %v", err)
+	}
 }
@@ -0,0 +1,165 @@
+package lookergo
+
+import (
+	"bufio"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// DefaultApiSettingsSection is the section of a looker.ini file read by the official Looker SDKs by default.
+const DefaultApiSettingsSection = "Looker"
+
+// ApiSettingsEnvPrefix is the prefix of the environment variables overriding the settings of a looker.ini file in the
+// official Looker SDKs, e.g. LOOKERSDK_BASE_URL.
+const ApiSettingsEnvPrefix = "LOOKERSDK_"
+
+// ApiSettings are the settings of a section of a looker.ini file, in the format of the official Looker SDKs:
+//
+//	[Looker]
+//	base_url=https://example.looker.com:19999
+//	client_id=...
+//	client_secret=...
+//	verify_ssl=True
+//	timeout=120
+//
+// Empty fields are not set in the section.
+type ApiSettings struct {
+	BaseURL      string
+	ClientId     string
+	ClientSecret string
+	VerifySSL    *bool
+	// Timeout of the requests, in seconds.
+	Timeout int
+}
+
+// LoadApiSettings reads the given section of the looker.ini file at path. Other keys than the ones of ApiSettings are
+// ignored.
+func LoadApiSettings(path string, section string) (*ApiSettings, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	settings := new(ApiSettings)
+	found := false
+	current := ""
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") || strings.HasPrefix(text, ";") {
+			continue
+		}
+		if strings.HasPrefix(text, "[") && strings.HasSuffix(text, "]") {
+			current = strings.TrimSpace(text[1 : len(text)-1])
+			found = found || current == section
+			continue
+		}
+		if current != section {
+			continue
+		}
+
+		i := strings.IndexAny(text, "=:")
+		if i < 0 {
+			return nil, fmt.Errorf("%s:%d: expected key=value", path, line)
+		}
+		key := strings.ToLower(strings.TrimSpace(text[:i]))
+		value := strings.Trim(strings.TrimSpace(text[i+1:]), `"'`)
+		switch key {
+		case "base_url":
+			settings.BaseURL = value
+		case "client_id":
+			settings.ClientId = value
+		case "client_secret":
+			settings.ClientSecret = value
+		case "verify_ssl":
+			verify, err := parseIniBool(value)
+			if err != nil {
+				return nil, fmt.Errorf("%s:%d: verify_ssl: %w", path, line, err)
+			}
+			settings.VerifySSL = &verify
+		case "timeout":
+			timeout, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, fmt.Errorf("%s:%d: timeout: %w", path, line, err)
+			}
+			settings.Timeout = timeout
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("section [%s] not found in %s", section, path)
+	}
+
+	return settings, nil
+}
+
+// ApplyEnv overrides the settings with the environment variables of the official Looker SDKs which are set:
+// LOOKERSDK_BASE_URL, LOOKERSDK_CLIENT_ID, LOOKERSDK_CLIENT_SECRET, LOOKERSDK_VERIFY_SSL and LOOKERSDK_TIMEOUT.
+func (s *ApiSettings) ApplyEnv() error {
+	if value, ok := os.LookupEnv(ApiSettingsEnvPrefix + "BASE_URL"); ok {
+		s.BaseURL = value
+	}
+	if value, ok := os.LookupEnv(ApiSettingsEnvPrefix + "CLIENT_ID"); ok {
+		s.ClientId = value
+	}
+	if value, ok := os.LookupEnv(ApiSettingsEnvPrefix + "CLIENT_SECRET"); ok {
+		s.ClientSecret = value
+	}
+	if value, ok := os.LookupEnv(ApiSettingsEnvPrefix + "VERIFY_SSL"); ok {
+		verify, err := parseIniBool(value)
+		if err != nil {
+			return fmt.Errorf("%sVERIFY_SSL: %w", ApiSettingsEnvPrefix, err)
+		}
+		s.VerifySSL = &verify
+	}
+	if value, ok := os.LookupEnv(ApiSettingsEnvPrefix + "TIMEOUT"); ok {
+		timeout, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("%sTIMEOUT: %w", ApiSettingsEnvPrefix, err)
+		}
+		s.Timeout = timeout
+	}
+	return nil
+}
+
+// parseIniBool parses a boolean the way the Looker SDKs do.
+func parseIniBool(value string) (bool, error) {
+	switch strings.ToLower(value) {
+	case "true", "t", "yes", "y", "1":
+		return true, nil
+	case "false", "f", "no", "n", "0":
+		return false, nil
+	}
+	return false, fmt.Errorf("invalid boolean %q", value)
+}
+
+// NewTLSConfig returns the TLS settings for an instance whose certificate is verified unless verifySSL is false, and
+// may be signed by the certificate authorities of the PEM file caCertFile besides the ones of the system.
+func NewTLSConfig(verifySSL bool, caCertFile string) (*tls.Config, error) {
+	config := &tls.Config{InsecureSkipVerify: !verifySSL}
+	if caCertFile == "" {
+		return config, nil
+	}
+
+	pem, err := os.ReadFile(caCertFile)
+	if err != nil {
+		return nil, err
+	}
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificate found in %s", caCertFile)
+	}
+	config.RootCAs = pool
+
+	return config, nil
+}
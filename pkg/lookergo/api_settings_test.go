@@ -0,0 +1,70 @@
+package lookergo
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestLoadApiSettings(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "looker.ini")
+	ini := `; Looker SDK settings
+[Looker]
+base_url=https://example.looker.com:19999
+client_id=abc
+client_secret="s3cr3t"
+api_version=4.0
+
+[Dev]
+base_url: https://dev.example.com
+client_id = def
+verify_ssl = False
+timeout=120
+`
+	if err := os.WriteFile(path, []byte(ini), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		section  string
+		expected *ApiSettings
+	}{
+		{DefaultApiSettingsSection, &ApiSettings{BaseURL: "https://example.looker.com:19999", ClientId: "abc", ClientSecret: "s3cr3t"}},
+		{"Dev", &ApiSettings{BaseURL: "https://dev.example.com", ClientId: "def", VerifySSL: Bool(false), Timeout: 120}},
+	} {
+		settings, err := LoadApiSettings(path, tc.section)
+		if err != nil {
+			t.Fatalf("LoadApiSettings(%s) returned error: %v", tc.section, err)
+		}
+		if !reflect.DeepEqual(settings, tc.expected) {
+			t.Error(errGotWant("LoadApiSettings("+tc.section+")", settings, tc.expected))
+		}
+	}
+
+	if _, err := LoadApiSettings(path, "Prod"); err == nil {
+		t.Error("LoadApiSettings(Prod) expected an error for a missing section")
+	}
+}
+
+func TestApiSettings_ApplyEnv(t *testing.T) {
+	t.Setenv("LOOKERSDK_BASE_URL", "https://env.example.com")
+	t.Setenv("LOOKERSDK_CLIENT_SECRET", "env-secret")
+	t.Setenv("LOOKERSDK_VERIFY_SSL", "false")
+	t.Setenv("LOOKERSDK_TIMEOUT", "30")
+
+	// Unset variables keep the settings of the file
+	settings := &ApiSettings{BaseURL: "https://example.looker.com:19999", ClientId: "abc", ClientSecret: "s3cr3t"}
+	if err := settings.ApplyEnv(); err != nil {
+		t.Fatalf("ApplyEnv returned error: %v", err)
+	}
+	expected := &ApiSettings{BaseURL: "https://env.example.com", ClientId: "abc", ClientSecret: "env-secret", VerifySSL: Bool(false), Timeout: 30}
+	if !reflect.DeepEqual(settings, expected) {
+		t.Error(errGotWant("ApplyEnv", settings, expected))
+	}
+
+	t.Setenv("LOOKERSDK_TIMEOUT", "soon")
+	if err := new(ApiSettings).ApplyEnv(); err == nil {
+		t.Error("ApplyEnv expected an error for an invalid LOOKERSDK_TIMEOUT")
+	}
+}
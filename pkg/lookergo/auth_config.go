@@ -0,0 +1,29 @@
+package lookergo
+
+// Types shared by the SAML, OIDC and LDAP authentication configurations.
+
+// AuthGroupMapping maps a group of the identity provider to a Looker group and the roles its members get.
+type AuthGroupMapping struct {
+	Id              string   `json:"id,omitempty"`                // Unique Id of the mapping
+	LookerGroupId   string   `json:"looker_group_id,omitempty"`   // Unique Id of group in Looker
+	LookerGroupName string   `json:"looker_group_name,omitempty"` // Name of group in Looker
+	Name            string   `json:"name"`                        // Name of group in the identity provider
+	RoleIds         []string `json:"role_ids"`                    // Looker Role Ids
+}
+
+// AuthUserAttributeMapping maps an attribute of the identity provider to Looker user attributes.
+type AuthUserAttributeMapping struct {
+	Name             string   `json:"name"`               // Name of user attribute in the identity provider
+	Required         bool     `json:"required"`           // Required to be in the identity provider assertion for login to be allowed to succeed
+	UserAttributeIds []string `json:"user_attribute_ids"` // Looker User Attribute Ids
+}
+
+// Values of NewUserMigrationTypes, comma separated, allowing existing users to log in with the authentication method
+// when their email matches.
+const (
+	NewUserMigrationType_EMAIL  = "email"
+	NewUserMigrationType_LDAP   = "ldap"
+	NewUserMigrationType_OIDC   = "oidc"
+	NewUserMigrationType_GOOGLE = "google"
+	NewUserMigrationType_SAML   = "saml"
+)
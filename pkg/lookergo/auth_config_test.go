@@ -0,0 +1,130 @@
+package lookergo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestSamlConfigResourceOp_Update(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/4.0/saml_config", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPatch)
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		expected := map[string]interface{}{
+			"enabled":              false,
+			"groups_with_role_ids": []interface{}{map[string]interface{}{"name": "Engineering", "role_ids": []interface{}{"2"}}},
+		}
+		if !reflect.DeepEqual(body, expected) {
+			t.Error(errGotWant("SamlConfig.Update request body", body, expected))
+		}
+		fmt.Fprint(w, `{"enabled":false,"groups_with_role_ids":[{"id":"1","looker_group_id":"5","name":"Engineering","role_ids":["2"]}]}`)
+	})
+
+	config, _, err := client.SamlConfig.Update(ctx, &SamlConfig{
+		Enabled:           Bool(false),
+		GroupsWithRoleIds: &[]AuthGroupMapping{{Name: "Engineering", RoleIds: []string{"2"}}},
+	})
+	if err != nil {
+		t.Fatalf("SamlConfig.Update returned error: %v", err)
+	}
+
+	expected := &SamlConfig{
+		Enabled:           Bool(false),
+		GroupsWithRoleIds: &[]AuthGroupMapping{{Id: "1", LookerGroupId: "5", Name: "Engineering", RoleIds: []string{"2"}}},
+	}
+	if !reflect.DeepEqual(config, expected) {
+		t.Error(errGotWant("SamlConfig.Update", config, expected))
+	}
+}
+
+func TestSamlConfigResourceOp_TestConfig(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/4.0/saml_test_configs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{"idp_url":"https://idp.example.com","test_slug":"abc"}`)
+	})
+	deleted := false
+	mux.HandleFunc("/4.0/saml_test_configs/abc", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		deleted = true
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	config, _, err := client.SamlConfig.CreateTestConfig(ctx, &SamlConfig{IdpUrl: String("https://idp.example.com")})
+	if err != nil {
+		t.Fatalf("SamlConfig.CreateTestConfig returned error: %v", err)
+	}
+	if config.TestSlug == nil || *config.TestSlug != "abc" {
+		t.Fatalf("SamlConfig.CreateTestConfig returned test slug %v, expected abc", config.TestSlug)
+	}
+
+	if _, err := client.SamlConfig.DeleteTestConfig(ctx, *config.TestSlug); err != nil {
+		t.Fatalf("SamlConfig.DeleteTestConfig returned error: %v", err)
+	}
+	if !deleted {
+		t.Error("SamlConfig.DeleteTestConfig did not delete the test config")
+	}
+
+	if _, err := client.SamlConfig.DeleteTestConfig(ctx, ""); err == nil {
+		t.Error("SamlConfig.DeleteTestConfig with an empty slug expected an error")
+	}
+}
+
+func TestOIDCConfigResourceOp_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/4.0/oidc_config", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"enabled":true,"issuer":"https://accounts.example.com","scopes":["openid","email"],"user_attributes_with_ids":[{"name":"dept","required":true,"user_attribute_ids":["12"]}]}`)
+	})
+
+	config, _, err := client.OIDCConfig.Get(ctx)
+	if err != nil {
+		t.Fatalf("OIDCConfig.Get returned error: %v", err)
+	}
+
+	expected := &OIDCConfig{
+		Enabled:               Bool(true),
+		Issuer:                String("https://accounts.example.com"),
+		Scopes:                &[]string{"openid", "email"},
+		UserAttributesWithIds: &[]AuthUserAttributeMapping{{Name: "dept", Required: true, UserAttributeIds: []string{"12"}}},
+	}
+	if !reflect.DeepEqual(config, expected) {
+		t.Error(errGotWant("OIDCConfig.Get", config, expected))
+	}
+}
+
+func TestLDAPConfigResourceOp_TestConnection(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/4.0/ldap_config/test_connection", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		fmt.Fprint(w, `{"status":"error","message":"Cannot connect","issues":[{"severity":"error","message":"Connection refused"}]}`)
+	})
+
+	result, _, err := client.LDAPConfig.TestConnection(ctx, &LDAPConfig{ConnectionHost: String("ldap.example.com")})
+	if err != nil {
+		t.Fatalf("LDAPConfig.TestConnection returned error: %v", err)
+	}
+
+	expected := &LDAPConfigTestResult{
+		Status:  LDAPConfigTestStatus_ERROR,
+		Message: "Cannot connect",
+		Issues:  []LDAPConfigTestIssue{{Severity: LDAPConfigTestSeverity_ERROR, Message: "Connection refused"}},
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Error(errGotWant("LDAPConfig.TestConnection", result, expected))
+	}
+}
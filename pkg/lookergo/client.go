@@ -3,14 +3,15 @@ package lookergo
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
-	"github.com/beefsack/go-rate"
 	"github.com/google/go-querystring/query"
 	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/clientcredentials"
 	"path"
 	"reflect"
+	"strconv"
 	"strings"
 	"time"
 
@@ -28,18 +29,18 @@ const (
 	mediaType      = "application/json"
 )
 
-var rl *rate.RateLimiter
-
-func init() {
-	rl = rate.New(1, 1000*time.Millisecond) // Once per second
-}
+const (
+	headerRateLimit     = "X-RateLimit-Limit"
+	headerRateRemaining = "X-RateLimit-Remaining"
+	headerRateReset     = "X-RateLimit-Reset"
+)
 
 // Rate contains the rate limit for the current client.
 type Rate struct {
-	// The number of request per hour the client is currently limited to.
+	// The number of requests per window the client is currently limited to.
 	Limit int `json:"limit"`
 
-	// The number of remaining requests the client can make this hour.
+	// The number of remaining requests the client can make in the current window.
 	Remaining int `json:"remaining"`
 
 	// The time at which the current rate limit will reset.
@@ -51,6 +52,9 @@ type Client struct {
 	// Pointer reference to a shared HTTP client for communicating with the API.
 	client *http.Client
 
+	// HTTP client the authenticated client is built upon, when the default one doesn't fit (e.g. TLS settings).
+	baseClient *http.Client
+
 	// Base URL for API requests.
 	BaseURL *url.URL
 
@@ -81,6 +85,17 @@ type Client struct {
 	UserAttributes    UserAttributesResource
 	EgressIpAddresses PublicEgressIpsResource
 	Themes            ThemesResource
+	Dashboards        DashboardsResource
+	Looks             LooksResource
+	Queries           QueriesResource
+	ScheduledPlans    ScheduledPlansResource
+	ContentMetadata   ContentMetadataResource
+	SamlConfig        SamlConfigResource
+	OIDCConfig        OIDCConfigResource
+	LDAPConfig        LDAPConfigResource
+	Datagroups        DatagroupsResource
+	DerivedTables     DerivedTablesResource
+	ContentValidation ContentValidationResource
 	// TODO: Expand
 
 	// Optional function called after every successful request made to the DO APIs
@@ -89,20 +104,30 @@ type Client struct {
 	// Optional extra HTTP headers to set on every request to the API.
 	headers map[string]string
 
+	// Policy used to retry failed requests
+	retryPolicy RetryPolicy
+
+	// Limits the requests per second sent by this client
+	limiter *rateLimiter
+
 	// Production or dev workspace
 	Workspace string
+
+	// Session providing the tokens of a dev workspace client
+	devSession *DevSession
 }
 
 // RequestCompletionCallback defines the type of the request callback function
 type RequestCompletionCallback func(*http.Request, *http.Response)
 
 // ListOptions specifies the optional parameters to various List methods that
-// support ~~pagination~~ limit/offset querystring.
+// support pagination through the limit/offset querystring.
+// When neither is set, List methods follow the pagination links and return the full result set.
 type ListOptions struct {
-	// For paginated result sets, page of results to retrieve.
+	// For paginated result sets, the number of results to include per page.
 	Limit int `url:"limit,omitempty"`
 
-	// For paginated result sets, the number of results to include per page.
+	// For paginated result sets, the number of results to skip before the first result of the page.
 	Offset int `url:"offset,omitempty"`
 }
 
@@ -110,6 +135,12 @@ type ListOptions struct {
 type Response struct {
 	*http.Response
 
+	// TotalCount is the size of the full result set of a paginated list, as reported by the X-Total-Count header.
+	TotalCount int
+
+	// Links to the first, previous, next and last pages of a paginated list.
+	Links Links
+
 	Rate
 }
 
@@ -157,7 +188,20 @@ func NewClient(httpClient *http.Client) *Client {
 	c.UserAttributes = &UserAttributesResourceOp{client: c}
 	c.EgressIpAddresses = &PublicEgressIpsResourceOp{client: c}
 	c.Themes = &ThemesResourceOp{client: c}
+	c.Dashboards = &DashboardsResourceOp{client: c}
+	c.Looks = &LooksResourceOp{client: c}
+	c.Queries = &QueriesResourceOp{client: c}
+	c.ScheduledPlans = &ScheduledPlansResourceOp{client: c}
+	c.ContentMetadata = &ContentMetadataResourceOp{client: c}
+	c.SamlConfig = &SamlConfigResourceOp{client: c}
+	c.OIDCConfig = &OIDCConfigResourceOp{client: c}
+	c.LDAPConfig = &LDAPConfigResourceOp{client: c}
+	c.Datagroups = &DatagroupsResourceOp{client: c}
+	c.DerivedTables = &DerivedTablesResourceOp{client: c}
+	c.ContentValidation = &ContentValidationResourceOp{client: c}
 	c.headers = make(map[string]string)
+	c.retryPolicy = DefaultRetryPolicy()
+	c.limiter = newRateLimiter(DefaultRequestsPerSecond)
 	c.Workspace = "production"
 
 	return c
@@ -266,8 +310,10 @@ func (c *Client) SetOauthCredentials(ctx context.Context, clientId string, clien
 		AuthStyle:    oauth2.AuthStyleInParams,
 	}
 
+	ctx = c.oauth2Context(ctx)
 	tokenSource := oauthConfig.TokenSource(ctx)
 	c.client = oauth2.NewClient(ctx, tokenSource)
+	c.client.Timeout = c.timeout()
 	return nil
 }
 
@@ -276,34 +322,89 @@ func (c *Client) SetOauthStaticToken(ctx context.Context, token *oauth2.Token) e
 		return fmt.Errorf("no token provided")
 	}
 
+	ctx = c.oauth2Context(ctx)
 	tokenSource := oauth2.StaticTokenSource(token)
 	c.client = oauth2.NewClient(ctx, tokenSource)
+	c.client.Timeout = c.timeout()
+	return nil
+}
+
+// SetTLSConfig is a client option for the TLS settings of the connections to the API, e.g. to trust the certificate
+// of a self-hosted instance. It has to be set before the credentials.
+func (c *Client) SetTLSConfig(config *tls.Config) error {
+	if config == nil {
+		return fmt.Errorf("no TLS config provided")
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = config
+	c.baseClient = &http.Client{Transport: transport, Timeout: c.timeout()}
+	return nil
+}
+
+// SetTimeout is a client option for the time limit of each request to the API, including reading its response. Zero
+// means no limit. It has to be set before the credentials.
+func (c *Client) SetTimeout(timeout time.Duration) error {
+	if timeout < 0 {
+		return fmt.Errorf("negative timeout %v", timeout)
+	}
+
+	if c.baseClient == nil {
+		c.baseClient = &http.Client{}
+	}
+	c.baseClient.Timeout = timeout
 	return nil
 }
 
+// timeout returns the time limit of the requests of c, zero meaning no limit.
+func (c *Client) timeout() time.Duration {
+	if c.baseClient == nil {
+		return 0
+	}
+	return c.baseClient.Timeout
+}
+
+// oauth2Context returns ctx carrying the base HTTP client of c, if any, for oauth2 to fetch tokens and send requests
+// with.
+func (c *Client) oauth2Context(ctx context.Context) context.Context {
+	if c.baseClient == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, oauth2.HTTPClient, c.baseClient)
+}
+
+// baseTransport returns the transport of the base HTTP client of c, nil meaning the default one.
+func (c *Client) baseTransport() http.RoundTripper {
+	if c.baseClient == nil {
+		return nil
+	}
+	return c.baseClient.Transport
+}
+
 func (c *Client) EnsureStaticToken(ctx context.Context, parentClient *Client, apiUserID string) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
-	if c.client.Transport == nil && c.Workspace != "dev" {
-		// Get duplicate API token for current user
-		token, _, err := parentClient.Sessions.GetLoginUserToken(ctx, apiUserID)
-		if err != nil {
-			return err
+	if old := c.devSession; old != nil {
+		if old.parent == parentClient && old.userId == apiUserID {
+			return nil
 		}
+	} else if c.client.Transport != nil || c.Workspace == "dev" {
+		return nil
+	}
 
-		err = c.SetOauthStaticToken(ctx, token)
-		if err != nil {
-			return err
-		}
+	// Log in as the current user in the dev workspace, refreshing the token before it expires
+	session := NewDevSession(parentClient, apiUserID)
+	if _, err := session.TokenContext(ctx); err != nil {
+		return err
+	}
+	old := c.devSession
+	c.useDevSession(session)
+	c.Workspace = "dev"
 
-		// Set dev workspace for dup token
-		session, _, err := c.Sessions.SetWorkspaceId(ctx, "dev")
-		if err != nil {
-			return err
-		} else if session.WorkspaceId == "production" || session.WorkspaceId == "" {
-			return fmt.Errorf("did not find dev workspace")
-		} else {
-			c.Workspace = session.WorkspaceId
+	// The replaced session is no longer used by the client
+	if old != nil {
+		if err := old.Logout(ctx); err != nil {
+			return fmt.Errorf("unable to log out of replaced dev session: %w", err)
 		}
 	}
 
@@ -318,9 +419,9 @@ func (c *Client) CreateDevConnection(ctx context.Context, rc RequestCompletionCa
 		return nil, nil, err
 	}
 
-	// Get duplicate API token for current user
-	token, _, err := c.Sessions.GetLoginUserToken(ctx, user.Id)
-	if err != nil {
+	// Log in as the current user in the dev workspace, refreshing the token before it expires
+	devSession := NewDevSession(c, user.Id)
+	if _, err := devSession.TokenContext(ctx); err != nil {
 		return nil, nil, err
 	}
 
@@ -329,40 +430,19 @@ func (c *Client) CreateDevConnection(ctx context.Context, rc RequestCompletionCa
 	if err := devClient.SetBaseURL(c.BaseURL.String()); err != nil {
 		return nil, nil, err
 	}
-
-	// Set token as static on dup client
-	err = devClient.SetOauthStaticToken(ctx, token)
-	if err != nil {
-		return nil, nil, err
-	}
+	devClient.baseClient = c.baseClient
+	devClient.useDevSession(devSession)
+	devClient.Workspace = "dev"
 
 	devClient.OnRequestCompleted(rc)
+	devClient.retryPolicy = c.retryPolicy
+	devClient.limiter = newRateLimiter(c.limiter.configuredLimit())
 
-	// Set dev workspace for dup token
-	session, _, err := devClient.Sessions.SetWorkspaceId(ctx, "dev")
-	if err != nil {
-		return nil, nil, err
-	}
-
-	if session.WorkspaceId != "dev" {
-		return nil, nil, fmt.Errorf("failed to set dev environment for" +
-			"duplicate dev workspace connection")
-	} else {
-		c.Workspace = "dev"
-	}
+	c.Workspace = "dev"
 
-	return devClient, session, err
+	return devClient, devSession.session, nil
 }
 
-/*
-TODO: Pagination.
-It is provided in the headers
-GET {{endpoint}}/4.0/users?limit=5
-(H) X-Total-Count: 107
-(H) Link: <https://x.cloud.looker.com:19999/api/4.0/users?limit=5&offset=0>; rel="first",<https://x.cloud.looker.com:19999/api/4.0/users?limit=5&offset=105>; rel="last",<https://reprise.cloud.looker.com:19999/api/4.0/users?limit=5&offset=5>; rel="next"
-
-*/
-
 // NewRequest creates an API request. A relative URL can be provided in urlStr, which will be resolved to the
 // BaseURL of the Client. Relative URLS should always be specified without a preceding slash. If specified, the
 // value pointed to by body is JSON encoded and included in as the request body.
@@ -414,10 +494,58 @@ func (c *Client) OnRequestCompleted(rc RequestCompletionCallback) {
 // newResponse creates a new Response for the provided http.Response
 func newResponse(r *http.Response) *Response {
 	response := Response{Response: r}
+	response.populatePageValues()
+	response.Rate, _ = ParseRate(r.Header)
 
 	return &response
 }
 
+// ParseRate parses the rate limit headers of an API response. The second return value is false unless the response
+// carries both the limit and the remaining number of requests: a Rate with a limit only is returned otherwise.
+func ParseRate(h http.Header) (Rate, bool) {
+	var rate Rate
+
+	get := func(name string) string {
+		if v := h.Get(name); v != "" {
+			return v
+		}
+		// IETF draft naming, without the X- prefix.
+		return h.Get(strings.TrimPrefix(name, "X-"))
+	}
+
+	limit, err := strconv.Atoi(get(headerRateLimit))
+	if err != nil {
+		return rate, false
+	}
+	rate.Limit = limit
+
+	remaining, err := strconv.Atoi(get(headerRateRemaining))
+	ok := err == nil
+	if ok {
+		rate.Remaining = remaining
+	}
+
+	if reset, err := strconv.ParseInt(get(headerRateReset), 10, 64); err == nil {
+		if reset < 1e9 {
+			// Seconds until the reset rather than a unix timestamp.
+			rate.Reset = Timestamp{time.Now().Add(time.Duration(reset) * time.Second).Truncate(time.Second)}
+		} else {
+			rate.Reset = Timestamp{time.Unix(reset, 0)}
+		}
+	}
+
+	return rate, ok
+}
+
+// GetRate returns the rate limit of the client as reported by the most recent API response carrying rate limit
+// headers. It is safe for concurrent use.
+func (c *Client) GetRate() Rate {
+	c.ratemtx.Lock()
+	defer c.ratemtx.Unlock()
+
+	return c.Rate
+}
+
 // DoRequest submits an HTTP request.
 func DoRequest(ctx context.Context, req *http.Request) (*http.Response, error) {
 	return DoRequestWithClient(ctx, http.DefaultClient, req)
@@ -439,17 +567,14 @@ func (r *ErrorResponse) Error() string {
 
 // Do sends an API request and returns the API response. The API response is JSON decoded and stored in the value
 // pointed to by v, or returned as an error if an API error has occurred. If v implements the io.Writer interface,
-// the raw response will be written to v, without attempting to decode it.
+// the raw response will be written to v, without attempting to decode it. Failed requests are retried according
+// to the RetryPolicy of the client.
 func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
-	resp, err := DoRequestWithClient(ctx, c.client, req)
+	resp, err := c.doWithRetry(ctx, req)
 	if err != nil {
 		return nil, err
 	}
 
-	if c.onRequestCompleted != nil {
-		c.onRequestCompleted(req, resp)
-	}
-
 	defer func() {
 		// Ensure the response body is fully read and closed
 		// before we reconnect, so that we reuse the same TCPConnection.
@@ -467,12 +592,12 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 		}
 	}()
 
-	c.ratemtx.Lock()
 	response := newResponse(resp)
-	rl.Wait()
-
-	c.Rate = response.Rate
-	c.ratemtx.Unlock()
+	if response.Rate.Limit > 0 {
+		c.ratemtx.Lock()
+		c.Rate = response.Rate
+		c.ratemtx.Unlock()
+	}
 
 	err = CheckResponse(resp)
 
@@ -557,7 +682,7 @@ func StreamToString(stream io.Reader) string {
 }
 
 type service interface {
-	Group | User | CredentialsEmail | Role | PermissionSet | Session | Project | GitBranch | Folder | UserAttribute | UserAttributeGroupValue | Alert | EgressIpAddresses | Theme
+	Group | User | CredentialsEmail | CredentialsApi3 | CredentialsTotp | Role | PermissionSet | Session | Project | GitBranch | Folder | UserAttribute | UserAttributeGroupValue | Alert | EgressIpAddresses | Theme | Look | ScheduledPlan | ContentMetadataAccess | ProjectValidation
 }
 
 // addOptions -
@@ -588,9 +713,17 @@ func addOptions(s string, opt interface{}) (string, error) {
 	return origURL.String(), nil
 }
 
-// doList is a generic list lookup
+// defaultPageSize is the limit of the pages requested when retrieving all pages. The API only sends pagination links
+// when a limit is set: without one, the result may be silently truncated.
+const defaultPageSize = 500
+
+// doList is a generic list lookup. Without limit/offset options, all pages are retrieved.
 func doList[T any](ctx context.Context, client *Client, basePath string, opt *ListOptions, svc *[]T, pathSuffix ...string) ([]T, *Response, error) {
 	path := fmt.Sprintf("%s%s", basePath, strings.Join(append([]string{""}, pathSuffix...), "/"))
+	if wantsAllPages(opt) {
+		return doListPages(ctx, client, path, &ListOptions{Limit: defaultPageSize}, svc)
+	}
+
 	path, err := addOptions(path, opt)
 	if err != nil {
 		return nil, nil, err
@@ -653,6 +786,10 @@ func doGetById[T any](ctx context.Context, client *Client, basePath string, id a
 
 func doListByX[T service](ctx context.Context, client *Client, basePath string, opt *ListOptions, svc *[]T, qs url.Values) ([]T, *Response, error) {
 	path := fmt.Sprintf("%s?%s", basePath, qs.Encode())
+	if wantsAllPages(opt) {
+		return doListPages(ctx, client, path, &ListOptions{Limit: defaultPageSize}, svc)
+	}
+
 	path, err := addOptions(path, opt)
 	if err != nil {
 		return nil, nil, err
@@ -671,6 +808,18 @@ func doListByX[T service](ctx context.Context, client *Client, basePath string,
 	return *svc, resp, err
 }
 
+// doSearch is a generic lookup of basePath/search, filtered by the url tags of search. Without limit/offset options,
+// all pages are retrieved.
+func doSearch[T service](ctx context.Context, client *Client, basePath string, search interface{}, opt *ListOptions, svc *[]T, pathSuffix ...string) ([]T, *Response, error) {
+	qs, err := query.Values(search)
+	if err != nil {
+		return nil, nil, err
+	}
+	path := fmt.Sprintf("%s/search%s", basePath, strings.Join(append([]string{""}, pathSuffix...), "/"))
+
+	return doListByX(ctx, client, path, opt, svc, qs)
+}
+
 func doCreate[T any, N any](ctx context.Context, client *Client, basePath string, svc *T, newSvc *N, pathSuffix ...string) (*N, *Response, error) {
 	path := fmt.Sprintf("%s%s", basePath, strings.Join(append([]string{""}, pathSuffix...), "/"))
 
@@ -751,9 +900,10 @@ func doUpdate[T any, U any](ctx context.Context, client *Client, basePath string
 	return uSvc, resp, err
 }
 
+// doSet replaces a list of associations with ids. An empty ids removes all associations.
 func doSet[T any](ctx context.Context, client *Client, basePath string, ids []string, svc *[]T, pathSuffix ...string) ([]T, *Response, error) {
-	if len(ids) < 1 {
-		return nil, nil, NewArgError("ids", "cannot be less than 1")
+	if ids == nil {
+		ids = []string{} // Send [] rather than null.
 	}
 
 	path := fmt.Sprintf("%s%s", basePath, strings.Join(append([]string{""}, pathSuffix...), "/"))
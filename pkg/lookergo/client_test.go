@@ -13,6 +13,7 @@ import (
 	"os"
 	"reflect"
 	"testing"
+	"time"
 )
 
 var (
@@ -192,3 +193,139 @@ func TestAuth5(t *testing.T) {
 	}
 
 }
+
+func TestParseRate(t *testing.T) {
+	h := http.Header{}
+	if _, ok := ParseRate(h); ok {
+		t.Error("ParseRate() without headers returned ok")
+	}
+
+	// A limit without remaining requests is not enough to tell how close the limit is
+	h.Set("X-RateLimit-Limit", "100")
+	if rate, ok := ParseRate(h); ok || rate.Limit != 100 {
+		t.Errorf("ParseRate() without remaining = %v, %v, expected limit 100 and !ok", rate, ok)
+	}
+
+	h.Set("X-RateLimit-Remaining", "42")
+	h.Set("X-RateLimit-Reset", "1700000000")
+	rate, ok := ParseRate(h)
+	if !ok {
+		t.Fatal("ParseRate() returned !ok")
+	}
+	expected := Rate{Limit: 100, Remaining: 42, Reset: Timestamp{time.Unix(1700000000, 0)}}
+	if !reflect.DeepEqual(rate, expected) {
+		t.Error(errGotWant("ParseRate", rate, expected))
+	}
+
+	// IETF draft headers, with the reset in seconds from now
+	h = http.Header{}
+	h.Set("RateLimit-Limit", "10")
+	h.Set("RateLimit-Remaining", "1")
+	h.Set("RateLimit-Reset", "60")
+	rate, _ = ParseRate(h)
+	if rate.Limit != 10 || rate.Remaining != 1 {
+		t.Errorf("ParseRate() = %v, expected limit 10 and remaining 1", rate)
+	}
+	if until := time.Until(rate.Reset.Time); until < 50*time.Second || until > 60*time.Second {
+		t.Errorf("Rate.Reset is %v from now, expected about a minute", until)
+	}
+}
+
+func TestDo_Rate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "60")
+		w.Header().Set("X-RateLimit-Remaining", "59")
+		w.Header().Set("X-RateLimit-Reset", "1700000000")
+		fmt.Fprint(w, `{}`)
+	})
+
+	req, _ := client.NewRequest(ctx, http.MethodGet, "/", nil)
+	resp, err := client.Do(context.Background(), req, nil)
+	if err != nil {
+		t.Fatalf("Do(): %v", err)
+	}
+
+	expected := Rate{Limit: 60, Remaining: 59, Reset: Timestamp{time.Unix(1700000000, 0)}}
+	if !reflect.DeepEqual(resp.Rate, expected) {
+		t.Error(errGotWant("Response.Rate", resp.Rate, expected))
+	}
+	if rate := client.GetRate(); !reflect.DeepEqual(rate, expected) {
+		t.Error(errGotWant("Client.GetRate", rate, expected))
+	}
+}
+
+func TestCheckResponse_ErrorHelpers(t *testing.T) {
+	setup()
+	defer teardown()
+
+	for _, code := range []int{http.StatusNotFound, http.StatusConflict, http.StatusUnprocessableEntity, http.StatusBadRequest} {
+		code := code
+		mux.HandleFunc(fmt.Sprintf("/%d", code), func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(code)
+			fmt.Fprint(w, `{"message":"error"}`)
+		})
+	}
+
+	tests := []struct {
+		code                                       int
+		wantNotFound, wantConflict, wantValidation bool
+	}{
+		{http.StatusNotFound, true, false, false},
+		{http.StatusConflict, false, true, false},
+		{http.StatusUnprocessableEntity, false, false, true},
+		{http.StatusBadRequest, false, false, false},
+	}
+	for _, tt := range tests {
+		req, _ := client.NewRequest(ctx, http.MethodGet, fmt.Sprintf("/%d", tt.code), nil)
+		_, err := client.Do(ctx, req, nil)
+		if err == nil {
+			t.Fatalf("Do() to %d returned no error", tt.code)
+		}
+		// Helpers also have to match errors wrapped by callers.
+		for _, e := range []error{err, fmt.Errorf("wrapped: %w", err)} {
+			if got := IsNotFound(e); got != tt.wantNotFound {
+				t.Errorf("IsNotFound(%d) = %v, expected %v", tt.code, got, tt.wantNotFound)
+			}
+			if got := IsConflict(e); got != tt.wantConflict {
+				t.Errorf("IsConflict(%d) = %v, expected %v", tt.code, got, tt.wantConflict)
+			}
+			if got := IsValidation(e); got != tt.wantValidation {
+				t.Errorf("IsValidation(%d) = %v, expected %v", tt.code, got, tt.wantValidation)
+			}
+		}
+	}
+
+	if IsNotFound(nil) {
+		t.Error("IsNotFound(nil) = true, expected false")
+	}
+}
+
+func TestSetTimeout(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/4.0/user", func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+		fmt.Fprint(w, `{"id":"60"}`)
+	})
+
+	c := NewClient(nil)
+	c.BaseURL = client.BaseURL
+	c.retryPolicy = RetryPolicy{}
+	if err := c.SetTimeout(-time.Second); err == nil {
+		t.Error("SetTimeout expected an error for a negative timeout")
+	}
+	if err := c.SetTimeout(50 * time.Millisecond); err != nil {
+		t.Fatalf("SetTimeout returned error: %v", err)
+	}
+	if err := c.SetOauthStaticToken(ctx, &oauth2.Token{AccessToken: "t1"}); err != nil {
+		t.Fatalf("SetOauthStaticToken returned error: %v", err)
+	}
+
+	if _, _, err := c.Sessions.GetCurrentUser(ctx); err == nil {
+		t.Error("Sessions.GetCurrentUser expected a timeout error")
+	}
+}
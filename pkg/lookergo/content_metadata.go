@@ -0,0 +1,104 @@
+package lookergo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const (
+	ContentMetadataBasePath       = "4.0/content_metadata"
+	ContentMetadataAccessBasePath = "4.0/content_metadata_access"
+)
+
+// ContentMetadataResource manages the access to content (folders, dashboards and looks). Every piece of content has a
+// content metadata object, referenced by the content_metadata_id of the content.
+type ContentMetadataResource interface {
+	Get(context.Context, string) (*ContentMetadata, *Response, error)
+	Update(context.Context, string, *ContentMetadata) (*ContentMetadata, *Response, error)
+	ListAccess(context.Context, string, *ListOptions) ([]ContentMetadataAccess, *Response, error)
+	CreateAccess(context.Context, *ContentMetadataAccess) (*ContentMetadataAccess, *Response, error)
+	UpdateAccess(context.Context, string, *ContentMetadataAccess) (*ContentMetadataAccess, *Response, error)
+	DeleteAccess(context.Context, string) (*Response, error)
+}
+
+type ContentMetadataResourceOp struct {
+	client *Client
+}
+
+var _ ContentMetadataResource = &ContentMetadataResourceOp{}
+
+// Valid permission types of a ContentMetadataAccess.
+const (
+	ContentMetadataPermission_VIEW = "view"
+	ContentMetadataPermission_EDIT = "edit"
+)
+
+type ContentMetadata struct {
+	Id           string          `json:"id,omitempty"`            // Unique Id
+	Name         string          `json:"name,omitempty"`          // Name or title of underlying content
+	ParentId     string          `json:"parent_id,omitempty"`     // Id of Parent Content
+	DashboardId  string          `json:"dashboard_id,omitempty"`  // Id of associated dashboard when content_type is "dashboard"
+	LookId       string          `json:"look_id,omitempty"`       // Id of associated look when content_type is "look"
+	FolderId     string          `json:"folder_id,omitempty"`     // Id of associated folder when content_type is "space"
+	ContentType  string          `json:"content_type,omitempty"`  // Content Type ("dashboard", "look", or "folder")
+	Inherits     *bool           `json:"inherits,omitempty"`      // Whether content inherits its access levels from parent
+	InheritingId string          `json:"inheriting_id,omitempty"` // Id of Inherited Content
+	Slug         string          `json:"slug,omitempty"`          // Content Slug
+	Can          map[string]bool `json:"can,omitempty"`           // Operations the current user is able to perform on this object
+}
+
+type ContentMetadataAccess struct {
+	Id                string `json:"id,omitempty"`                  // Unique Id
+	ContentMetadataId string `json:"content_metadata_id,omitempty"` // Id of associated Content Metadata
+	PermissionType    string `json:"permission_type,omitempty"`     // Type of permission: "view" or "edit"
+	GroupId           string `json:"group_id,omitempty"`            // Id of associated group
+	UserId            string `json:"user_id,omitempty"`             // Id of associated user
+}
+
+func (s *ContentMetadataResourceOp) Get(ctx context.Context, ContentMetadataId string) (*ContentMetadata, *Response, error) {
+	return doGetById(ctx, s.client, ContentMetadataBasePath, ContentMetadataId, new(ContentMetadata))
+}
+
+// Update updates the content metadata. Only inherits can be changed.
+func (s *ContentMetadataResourceOp) Update(ctx context.Context, ContentMetadataId string, requestContentMetadata *ContentMetadata) (*ContentMetadata, *Response, error) {
+	return doUpdate(ctx, s.client, ContentMetadataBasePath, ContentMetadataId, requestContentMetadata, new(ContentMetadata))
+}
+
+// ListAccess returns the access grants of the content metadata with the given id.
+func (s *ContentMetadataResourceOp) ListAccess(ctx context.Context, ContentMetadataId string, opt *ListOptions) ([]ContentMetadataAccess, *Response, error) {
+	if ContentMetadataId == "" {
+		return nil, nil, NewArgError("ContentMetadataId", "has to be non-empty")
+	}
+	qs := url.Values{}
+	qs.Add("content_metadata_id", ContentMetadataId)
+
+	return doListByX(ctx, s.client, ContentMetadataAccessBasePath, opt, new([]ContentMetadataAccess), qs)
+}
+
+func (s *ContentMetadataResourceOp) CreateAccess(ctx context.Context, requestAccess *ContentMetadataAccess) (*ContentMetadataAccess, *Response, error) {
+	return doCreate(ctx, s.client, ContentMetadataAccessBasePath, requestAccess, new(ContentMetadataAccess))
+}
+
+// UpdateAccess replaces the access grant with the given id.
+func (s *ContentMetadataResourceOp) UpdateAccess(ctx context.Context, AccessId string, requestAccess *ContentMetadataAccess) (*ContentMetadataAccess, *Response, error) {
+	path := fmt.Sprintf("%s/%s", ContentMetadataAccessBasePath, AccessId)
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path, requestAccess)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	access := new(ContentMetadataAccess)
+	resp, err := s.client.Do(ctx, req, access)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return access, resp, err
+}
+
+func (s *ContentMetadataResourceOp) DeleteAccess(ctx context.Context, AccessId string) (*Response, error) {
+	return doDelete(ctx, s.client, ContentMetadataAccessBasePath, AccessId)
+}
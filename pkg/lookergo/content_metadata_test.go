@@ -0,0 +1,139 @@
+package lookergo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestContentMetadataResourceOp_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/4.0/content_metadata/88", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id":"88","name":"Sales","parent_id":"1","folder_id":"34","content_type":"space","inherits":false}`)
+	})
+
+	metadata, _, err := client.ContentMetadata.Get(ctx, "88")
+	if err != nil {
+		t.Fatalf("ContentMetadata.Get returned error: %v", err)
+	}
+
+	expected := &ContentMetadata{Id: "88", Name: "Sales", ParentId: "1", FolderId: "34", ContentType: "space", Inherits: Bool(false)}
+	if !reflect.DeepEqual(metadata, expected) {
+		t.Error(errGotWant("ContentMetadata.Get", metadata, expected))
+	}
+}
+
+func TestContentMetadataResourceOp_Update(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/4.0/content_metadata/88", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPatch)
+		body := map[string]interface{}{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("decode request body: %v", err)
+		}
+		expected := map[string]interface{}{"inherits": true}
+		if !reflect.DeepEqual(body, expected) {
+			t.Errorf("Request body = %v, expected %v", body, expected)
+		}
+		fmt.Fprint(w, `{"id":"88","inherits":true,"inheriting_id":"1"}`)
+	})
+
+	metadata, _, err := client.ContentMetadata.Update(ctx, "88", &ContentMetadata{Inherits: Bool(true)})
+	if err != nil {
+		t.Fatalf("ContentMetadata.Update returned error: %v", err)
+	}
+	if metadata.InheritingId != "1" {
+		t.Errorf("ContentMetadata.Update returned inheriting_id %q, expected %q", metadata.InheritingId, "1")
+	}
+}
+
+func TestContentMetadataResourceOp_ListAccess(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/4.0/content_metadata_access", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testFormValues(t, r, values{"content_metadata_id": "88", "limit": "500"})
+		fmt.Fprint(w, `[{"id":"1","content_metadata_id":"88","permission_type":"view","group_id":"5"},
+			{"id":"2","content_metadata_id":"88","permission_type":"edit","user_id":"9"}]`)
+	})
+
+	accesses, _, err := client.ContentMetadata.ListAccess(ctx, "88", nil)
+	if err != nil {
+		t.Fatalf("ContentMetadata.ListAccess returned error: %v", err)
+	}
+
+	expected := []ContentMetadataAccess{
+		{Id: "1", ContentMetadataId: "88", PermissionType: ContentMetadataPermission_VIEW, GroupId: "5"},
+		{Id: "2", ContentMetadataId: "88", PermissionType: ContentMetadataPermission_EDIT, UserId: "9"},
+	}
+	if !reflect.DeepEqual(accesses, expected) {
+		t.Error(errGotWant("ContentMetadata.ListAccess", accesses, expected))
+	}
+}
+
+func TestContentMetadataResourceOp_CreateAccess(t *testing.T) {
+	setup()
+	defer teardown()
+
+	request := &ContentMetadataAccess{ContentMetadataId: "88", PermissionType: ContentMetadataPermission_VIEW, GroupId: "5"}
+
+	mux.HandleFunc("/4.0/content_metadata_access", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		body := new(ContentMetadataAccess)
+		if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+			t.Fatalf("decode request body: %v", err)
+		}
+		if !reflect.DeepEqual(body, request) {
+			t.Error(errGotWant("Request body", body, request))
+		}
+		fmt.Fprint(w, `{"id":"3","content_metadata_id":"88","permission_type":"view","group_id":"5"}`)
+	})
+
+	access, _, err := client.ContentMetadata.CreateAccess(ctx, request)
+	if err != nil {
+		t.Fatalf("ContentMetadata.CreateAccess returned error: %v", err)
+	}
+	if access.Id != "3" {
+		t.Errorf("ContentMetadata.CreateAccess returned id %q, expected %q", access.Id, "3")
+	}
+}
+
+func TestContentMetadataResourceOp_UpdateAccess(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/4.0/content_metadata_access/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		fmt.Fprint(w, `{"id":"3","content_metadata_id":"88","permission_type":"edit","group_id":"5"}`)
+	})
+
+	access, _, err := client.ContentMetadata.UpdateAccess(ctx, "3", &ContentMetadataAccess{ContentMetadataId: "88", PermissionType: ContentMetadataPermission_EDIT, GroupId: "5"})
+	if err != nil {
+		t.Fatalf("ContentMetadata.UpdateAccess returned error: %v", err)
+	}
+	if access.PermissionType != ContentMetadataPermission_EDIT {
+		t.Errorf("ContentMetadata.UpdateAccess returned permission_type %q, expected %q", access.PermissionType, ContentMetadataPermission_EDIT)
+	}
+}
+
+func TestContentMetadataResourceOp_DeleteAccess(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/4.0/content_metadata_access/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.ContentMetadata.DeleteAccess(ctx, "3"); err != nil {
+		t.Errorf("ContentMetadata.DeleteAccess returned error: %v", err)
+	}
+}
@@ -0,0 +1,72 @@
+package lookergo
+
+import (
+	"context"
+)
+
+const ContentValidationBasePath = "4.0/content_validation"
+
+// ContentValidationResource runs the content validator, which finds the looks, dashboards, schedules and alerts
+// referencing LookML fields that no longer exist.
+// Ref: https://developers.looker.com/api/explorer/4.0/methods/Content/content_validation
+type ContentValidationResource interface {
+	Validate(ctx context.Context) (*ContentValidation, *Response, error)
+}
+
+type ContentValidationResourceOp struct {
+	client *Client
+}
+
+var _ ContentValidationResource = &ContentValidationResourceOp{}
+
+// ContentValidation is the result of the content validator. Only the looks and dashboards of the broken content are
+// modelled.
+type ContentValidation struct {
+	ContentWithErrors               []ContentValidatorError `json:"content_with_errors,omitempty"`                // Content with broken references
+	ComputationTime                 float64                 `json:"computation_time,omitempty"`                   // Duration of content validation in seconds
+	TotalLooksValidated             int64                   `json:"total_looks_validated,omitempty"`              // The number of looks validated
+	TotalDashboardElementsValidated int64                   `json:"total_dashboard_elements_validated,omitempty"` // The number of dashboard elements validated
+	TotalDashboardFiltersValidated  int64                   `json:"total_dashboard_filters_validated,omitempty"`  // The number of dashboard filters validated
+	TotalScheduledPlansValidated    int64                   `json:"total_scheduled_plans_validated,omitempty"`    // The number of scheduled plans validated
+	TotalAlertsValidated            int64                   `json:"total_alerts_validated,omitempty"`             // The number of alerts validated
+	TotalExploresValidated          int64                   `json:"total_explores_validated,omitempty"`           // The number of explores used across all content validated
+}
+
+// ContentValidatorError is a piece of content with broken references.
+type ContentValidatorError struct {
+	Id        string                      `json:"id,omitempty"`        // ID of the content
+	Look      *ContentValidationLook      `json:"look,omitempty"`      // Set if the content is a look
+	Dashboard *ContentValidationDashboard `json:"dashboard,omitempty"` // Set if the content is, or is part of, a dashboard
+	Errors    []ContentValidationError    `json:"errors,omitempty"`    // Broken references of the content
+}
+
+type ContentValidationLook struct {
+	Id     string                   `json:"id,omitempty"`
+	Title  string                   `json:"title,omitempty"`
+	Folder *ContentValidationFolder `json:"folder,omitempty"`
+}
+
+type ContentValidationDashboard struct {
+	Id     string                   `json:"id,omitempty"`
+	Title  string                   `json:"title,omitempty"`
+	Folder *ContentValidationFolder `json:"folder,omitempty"`
+}
+
+type ContentValidationFolder struct {
+	Id   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// ContentValidationError is a broken reference of a piece of content.
+type ContentValidationError struct {
+	Message     string `json:"message,omitempty"`      // Error message
+	FieldName   string `json:"field_name,omitempty"`   // Name of the field involved in the error
+	ModelName   string `json:"model_name,omitempty"`   // Name of the model involved in the error
+	ExploreName string `json:"explore_name,omitempty"` // Name of the explore involved in the error
+	Removable   bool   `json:"removable,omitempty"`    // Whether this validation error is removable
+}
+
+// Validate runs the content validator on all the content of the instance.
+func (s *ContentValidationResourceOp) Validate(ctx context.Context) (*ContentValidation, *Response, error) {
+	return doGet(ctx, s.client, ContentValidationBasePath, new(ContentValidation))
+}
@@ -0,0 +1,214 @@
+package lookergo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const (
+	DashboardsBasePath        = "4.0/dashboards"
+	DashboardElementsBasePath = "4.0/dashboard_elements"
+	DashboardFiltersBasePath  = "4.0/dashboard_filters"
+)
+
+type DashboardsResource interface {
+	List(context.Context, *ListOptions) ([]DashboardBase, *Response, error)
+	Get(context.Context, string) (*Dashboard, *Response, error)
+	Create(context.Context, *Dashboard) (*Dashboard, *Response, error)
+	Update(context.Context, string, *Dashboard) (*Dashboard, *Response, error)
+	Delete(context.Context, string) (*Response, error)
+	Move(context.Context, string, string) (*Dashboard, *Response, error)
+	ImportLookml(context.Context, string, string, *Dashboard) (*Dashboard, *Response, error)
+	SyncLookml(context.Context, string, *Dashboard) ([]int, *Response, error)
+	GetLookml(context.Context, string) (*DashboardLookml, *Response, error)
+	ImportFromLookml(context.Context, *DashboardLookml) (*Dashboard, *Response, error)
+	ListElements(context.Context, string) ([]DashboardElement, *Response, error)
+	CreateElement(context.Context, *DashboardElement) (*DashboardElement, *Response, error)
+	UpdateElement(context.Context, string, *DashboardElement) (*DashboardElement, *Response, error)
+	DeleteElement(context.Context, string) (*Response, error)
+	ListFilters(context.Context, string) ([]DashboardFilter, *Response, error)
+	CreateFilter(context.Context, *DashboardFilter) (*DashboardFilter, *Response, error)
+	UpdateFilter(context.Context, string, *DashboardFilter) (*DashboardFilter, *Response, error)
+	DeleteFilter(context.Context, string) (*Response, error)
+}
+
+type DashboardsResourceOp struct {
+	client *Client
+}
+
+var _ DashboardsResource = &DashboardsResourceOp{}
+
+type Dashboard struct {
+	ContentFavoriteId   string             `json:"content_favorite_id,omitempty"`   // Content Favorite Id
+	ContentMetadataId   string             `json:"content_metadata_id,omitempty"`   // Id of content metadata
+	CrossfilterEnabled  *bool              `json:"crossfilter_enabled,omitempty"`   // Enables crossfiltering in dashboards - only available in dashboards-next (beta)
+	Deleted             *bool              `json:"deleted,omitempty"`               // Whether or not a dashboard is 'soft' deleted.
+	Description         *string            `json:"description,omitempty"`           // Description
+	FiltersBarCollapsed *bool              `json:"filters_bar_collapsed,omitempty"` // Sets the default state of the filters bar to collapsed or open
+	Hidden              *bool              `json:"hidden,omitempty"`                // Is Hidden
+	Id                  string             `json:"id,omitempty"`                    // Unique Id
+	LoadConfiguration   *string            `json:"load_configuration,omitempty"`    // configuration option that governs how dashboard loading will happen.
+	Model               *LookModel         `json:"model,omitempty"`
+	QueryTimezone       *string            `json:"query_timezone,omitempty"`        // Timezone in which the Dashboard will run by default.
+	Readonly            bool               `json:"readonly,omitempty"`              // Is Read-only
+	RefreshInterval     *string            `json:"refresh_interval,omitempty"`      // Refresh Interval, as a time duration phrase like "2 hours 30 minutes". A number with no time units will be interpreted as whole seconds.
+	RefreshIntervalToI  int64              `json:"refresh_interval_to_i,omitempty"` // Refresh Interval in milliseconds
+	Folder              *Folder            `json:"folder,omitempty"`
+	FolderId            string             `json:"folder_id,omitempty"`          // Id of folder
+	Title               string             `json:"title,omitempty"`              // Dashboard Title
+	UserId              string             `json:"user_id,omitempty"`            // Id of User
+	Slug                string             `json:"slug,omitempty"`               // Content Metadata Slug
+	PreferredViewer     *string            `json:"preferred_viewer,omitempty"`   // The preferred route for viewing this dashboard (ie: dashboards or dashboards-next)
+	DashboardElements   []DashboardElement `json:"dashboard_elements,omitempty"` // Elements
+	DashboardFilters    []DashboardFilter  `json:"dashboard_filters,omitempty"`  // Filters
+}
+
+type DashboardElement struct {
+	Id              string  `json:"id,omitempty"`               // Unique Id
+	DashboardId     string  `json:"dashboard_id,omitempty"`     // Id of Dashboard
+	Type            string  `json:"type,omitempty"`             // Type
+	Title           *string `json:"title,omitempty"`            // Title of dashboard element
+	TitleHidden     *bool   `json:"title_hidden,omitempty"`     // Whether title is hidden
+	TitleText       *string `json:"title_text,omitempty"`       // Text tile title
+	SubtitleText    *string `json:"subtitle_text,omitempty"`    // Text tile subtitle text
+	BodyText        *string `json:"body_text,omitempty"`        // Text tile body text
+	NoteText        *string `json:"note_text,omitempty"`        // Note Text
+	NoteDisplay     *string `json:"note_display,omitempty"`     // Note Display
+	NoteState       *string `json:"note_state,omitempty"`       // Note State
+	LookId          *string `json:"look_id,omitempty"`          // Id Of Look
+	QueryId         *string `json:"query_id,omitempty"`         // Id Of Query
+	MergeResultId   *string `json:"merge_result_id,omitempty"`  // ID of merge result
+	RefreshInterval *string `json:"refresh_interval,omitempty"` // Refresh Interval
+}
+
+type DashboardFilter struct {
+	Id                  string   `json:"id,omitempty"`                    // Unique Id
+	DashboardId         string   `json:"dashboard_id,omitempty"`          // Id of Dashboard
+	Name                string   `json:"name,omitempty"`                  // Name of filter
+	Title               string   `json:"title,omitempty"`                 // Title of filter
+	Type                string   `json:"type,omitempty"`                  // Type of filter: one of date, number, string, or field
+	DefaultValue        *string  `json:"default_value,omitempty"`         // Default value of filter
+	Model               *string  `json:"model,omitempty"`                 // Model of filter (required if type = field)
+	Explore             *string  `json:"explore,omitempty"`               // Explore of filter (required if type = field)
+	Dimension           *string  `json:"dimension,omitempty"`             // Dimension of filter (required if type = field)
+	Row                 *int64   `json:"row,omitempty"`                   // Display order of this filter relative to other filters
+	ListensToFilters    []string `json:"listens_to_filters,omitempty"`    // Array of listeners for faceted filters
+	AllowMultipleValues *bool    `json:"allow_multiple_values,omitempty"` // Whether the filter allows multiple filter values
+	Required            *bool    `json:"required,omitempty"`              // Whether the filter requires a value to run the dashboard
+}
+
+// DashboardLookml is the LookML (YAML) serialization of a user defined dashboard.
+type DashboardLookml struct {
+	DashboardId string `json:"dashboard_id,omitempty"` // Id of Dashboard
+	FolderId    string `json:"folder_id,omitempty"`    // (Write-Only) Id of the folder
+	Lookml      string `json:"lookml,omitempty"`       // lookml of UDD
+}
+
+func (s *DashboardsResourceOp) List(ctx context.Context, opt *ListOptions) ([]DashboardBase, *Response, error) {
+	return doList(ctx, s.client, DashboardsBasePath, opt, new([]DashboardBase))
+}
+
+func (s *DashboardsResourceOp) Get(ctx context.Context, DashboardId string) (*Dashboard, *Response, error) {
+	return doGetById(ctx, s.client, DashboardsBasePath, DashboardId, new(Dashboard))
+}
+
+func (s *DashboardsResourceOp) Create(ctx context.Context, requestDashboard *Dashboard) (*Dashboard, *Response, error) {
+	return doCreate(ctx, s.client, DashboardsBasePath, requestDashboard, new(Dashboard))
+}
+
+func (s *DashboardsResourceOp) Update(ctx context.Context, DashboardId string, requestDashboard *Dashboard) (*Dashboard, *Response, error) {
+	return doUpdate(ctx, s.client, DashboardsBasePath, DashboardId, requestDashboard, new(Dashboard))
+}
+
+func (s *DashboardsResourceOp) Delete(ctx context.Context, DashboardId string) (*Response, error) {
+	return doDelete(ctx, s.client, DashboardsBasePath, DashboardId)
+}
+
+// Move moves the dashboard to the folder with the given id.
+func (s *DashboardsResourceOp) Move(ctx context.Context, DashboardId string, FolderId string) (*Dashboard, *Response, error) {
+	if FolderId == "" {
+		return nil, nil, NewArgError("FolderId", "has to be non-empty")
+	}
+	qs := url.Values{}
+	qs.Add("folder_id", FolderId)
+	path := fmt.Sprintf("%s/%s/move?%s", DashboardsBasePath, DashboardId, qs.Encode())
+
+	req, err := s.client.NewRequest(ctx, http.MethodPatch, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dashboard := new(Dashboard)
+	resp, err := s.client.Do(ctx, req, dashboard)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return dashboard, resp, err
+}
+
+// ImportLookml creates a user defined dashboard in the given folder from the LookML dashboard with the given id
+// (e.g. `model_name::dashboard_name`). Fields set in requestDashboard override the ones of the LookML dashboard.
+func (s *DashboardsResourceOp) ImportLookml(ctx context.Context, LookmlDashboardId string, FolderId string, requestDashboard *Dashboard) (*Dashboard, *Response, error) {
+	if requestDashboard == nil {
+		requestDashboard = &Dashboard{}
+	}
+	return doCreate(ctx, s.client, DashboardsBasePath, requestDashboard, new(Dashboard), LookmlDashboardId, "import", FolderId)
+}
+
+// SyncLookml updates all user defined dashboards linked to the LookML dashboard with the given id and returns the
+// ids of the updated dashboards.
+func (s *DashboardsResourceOp) SyncLookml(ctx context.Context, LookmlDashboardId string, requestDashboard *Dashboard) ([]int, *Response, error) {
+	if requestDashboard == nil {
+		requestDashboard = &Dashboard{}
+	}
+	ids, resp, err := doUpdate(ctx, s.client, DashboardsBasePath, LookmlDashboardId, requestDashboard, new([]int), "sync")
+	if err != nil {
+		return nil, resp, err
+	}
+	return *ids, resp, err
+}
+
+// GetLookml exports the user defined dashboard with the given id as LookML.
+func (s *DashboardsResourceOp) GetLookml(ctx context.Context, DashboardId string) (*DashboardLookml, *Response, error) {
+	return doGet(ctx, s.client, DashboardsBasePath, new(DashboardLookml), "lookml", DashboardId)
+}
+
+// ImportFromLookml creates a user defined dashboard in requestLookml.FolderId from its LookML serialization.
+func (s *DashboardsResourceOp) ImportFromLookml(ctx context.Context, requestLookml *DashboardLookml) (*Dashboard, *Response, error) {
+	return doCreate(ctx, s.client, DashboardsBasePath, requestLookml, new(Dashboard), "lookml")
+}
+
+func (s *DashboardsResourceOp) ListElements(ctx context.Context, DashboardId string) ([]DashboardElement, *Response, error) {
+	return doList(ctx, s.client, DashboardsBasePath, nil, new([]DashboardElement), DashboardId, "dashboard_elements")
+}
+
+func (s *DashboardsResourceOp) CreateElement(ctx context.Context, requestElement *DashboardElement) (*DashboardElement, *Response, error) {
+	return doCreate(ctx, s.client, DashboardElementsBasePath, requestElement, new(DashboardElement))
+}
+
+func (s *DashboardsResourceOp) UpdateElement(ctx context.Context, ElementId string, requestElement *DashboardElement) (*DashboardElement, *Response, error) {
+	return doUpdate(ctx, s.client, DashboardElementsBasePath, ElementId, requestElement, new(DashboardElement))
+}
+
+func (s *DashboardsResourceOp) DeleteElement(ctx context.Context, ElementId string) (*Response, error) {
+	return doDelete(ctx, s.client, DashboardElementsBasePath, ElementId)
+}
+
+func (s *DashboardsResourceOp) ListFilters(ctx context.Context, DashboardId string) ([]DashboardFilter, *Response, error) {
+	return doList(ctx, s.client, DashboardsBasePath, nil, new([]DashboardFilter), DashboardId, "dashboard_filters")
+}
+
+func (s *DashboardsResourceOp) CreateFilter(ctx context.Context, requestFilter *DashboardFilter) (*DashboardFilter, *Response, error) {
+	return doCreate(ctx, s.client, DashboardFiltersBasePath, requestFilter, new(DashboardFilter))
+}
+
+func (s *DashboardsResourceOp) UpdateFilter(ctx context.Context, FilterId string, requestFilter *DashboardFilter) (*DashboardFilter, *Response, error) {
+	return doUpdate(ctx, s.client, DashboardFiltersBasePath, FilterId, requestFilter, new(DashboardFilter))
+}
+
+func (s *DashboardsResourceOp) DeleteFilter(ctx context.Context, FilterId string) (*Response, error) {
+	return doDelete(ctx, s.client, DashboardFiltersBasePath, FilterId)
+}
@@ -0,0 +1,117 @@
+package lookergo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestDashboardsResourceOp_Move(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/4.0/dashboards/12/move", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPatch)
+		testFormValues(t, r, values{"folder_id": "34"})
+		fmt.Fprint(w, `{"id":"12","title":"Sales","folder_id":"34"}`)
+	})
+
+	dashboard, _, err := client.Dashboards.Move(ctx, "12", "34")
+	if err != nil {
+		t.Fatalf("Dashboards.Move returned error: %v", err)
+	}
+
+	expected := &Dashboard{Id: "12", Title: "Sales", FolderId: "34"}
+	if !reflect.DeepEqual(dashboard, expected) {
+		t.Error(errGotWant("Dashboards.Move", dashboard, expected))
+	}
+}
+
+func TestDashboardsResourceOp_ImportLookml(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/4.0/dashboards/thelook::sales/import/34", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{"id":"12","title":"Sales","folder_id":"34"}`)
+	})
+
+	dashboard, _, err := client.Dashboards.ImportLookml(ctx, "thelook::sales", "34", nil)
+	if err != nil {
+		t.Fatalf("Dashboards.ImportLookml returned error: %v", err)
+	}
+	if dashboard.Id != "12" {
+		t.Errorf("Dashboards.ImportLookml returned id %q, expected %q", dashboard.Id, "12")
+	}
+}
+
+func TestDashboardsResourceOp_SyncLookml(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/4.0/dashboards/thelook::sales/sync", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPatch)
+		fmt.Fprint(w, `[12, 13]`)
+	})
+
+	ids, _, err := client.Dashboards.SyncLookml(ctx, "thelook::sales", nil)
+	if err != nil {
+		t.Fatalf("Dashboards.SyncLookml returned error: %v", err)
+	}
+
+	expected := []int{12, 13}
+	if !reflect.DeepEqual(ids, expected) {
+		t.Error(errGotWant("Dashboards.SyncLookml", ids, expected))
+	}
+}
+
+func TestDashboardsResourceOp_ImportFromLookml(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/4.0/dashboards/lookml", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var body DashboardLookml
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("decode request body: %v", err)
+		}
+		expected := DashboardLookml{FolderId: "34", Lookml: "- dashboard: sales"}
+		if body != expected {
+			t.Errorf("Request body = %+v, expected %+v", body, expected)
+		}
+		fmt.Fprint(w, `{"id":"12","title":"Sales","folder_id":"34"}`)
+	})
+
+	dashboard, _, err := client.Dashboards.ImportFromLookml(ctx, &DashboardLookml{FolderId: "34", Lookml: "- dashboard: sales"})
+	if err != nil {
+		t.Fatalf("Dashboards.ImportFromLookml returned error: %v", err)
+	}
+	if dashboard.Id != "12" {
+		t.Errorf("Dashboards.ImportFromLookml returned id %q, expected %q", dashboard.Id, "12")
+	}
+}
+
+func TestDashboardsResourceOp_ListElements(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/4.0/dashboards/12/dashboard_elements", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[{"id":"1","dashboard_id":"12","type":"vis","look_id":"7"},{"id":"2","dashboard_id":"12","type":"text","body_text":"Hello"}]`)
+	})
+
+	elements, _, err := client.Dashboards.ListElements(ctx, "12")
+	if err != nil {
+		t.Fatalf("Dashboards.ListElements returned error: %v", err)
+	}
+
+	expected := []DashboardElement{
+		{Id: "1", DashboardId: "12", Type: "vis", LookId: String("7")},
+		{Id: "2", DashboardId: "12", Type: "text", BodyText: String("Hello")},
+	}
+	if !reflect.DeepEqual(elements, expected) {
+		t.Error(errGotWant("Dashboards.ListElements", elements, expected))
+	}
+}
@@ -0,0 +1,52 @@
+package lookergo
+
+import (
+	"context"
+)
+
+const DatagroupsBasePath = "4.0/datagroups"
+
+// DatagroupsResource is an interface for interfacing with the Datagroup endpoints of the API. A datagroup defines when
+// the caches and persistent derived tables of a model are invalidated.
+// Ref: https://developers.looker.com/api/explorer/4.0/methods/Datagroup
+type DatagroupsResource interface {
+	List(ctx context.Context) ([]Datagroup, *Response, error)
+	Get(ctx context.Context, id string) (*Datagroup, *Response, error)
+	Update(ctx context.Context, id string, datagroup *Datagroup) (*Datagroup, *Response, error)
+}
+
+type DatagroupsResourceOp struct {
+	client *Client
+}
+
+var _ DatagroupsResource = &DatagroupsResourceOp{}
+
+// Datagroup of a model. All timestamps are in seconds since the epoch.
+type Datagroup struct {
+	Id             string          `json:"id,omitempty"`               // Unique ID of the datagroup
+	ModelName      string          `json:"model_name,omitempty"`       // Name of the model containing the datagroup
+	Name           string          `json:"name,omitempty"`             // Name of the datagroup
+	CreatedAt      int64           `json:"created_at,omitempty"`       // When the datagroup was created
+	StaleBefore    int64           `json:"stale_before,omitempty"`     // Cache entries and PDTs built before this time are stale. Can be set by Update.
+	TriggeredAt    int64           `json:"triggered_at,omitempty"`     // When the datagroup was last triggered. Can be set by Update to trigger it.
+	TriggerCheckAt int64           `json:"trigger_check_at,omitempty"` // When the SQL trigger was last checked
+	TriggerError   string          `json:"trigger_error,omitempty"`    // Error of the last SQL trigger check
+	TriggerValue   string          `json:"trigger_value,omitempty"`    // Value of the last SQL trigger check
+	Can            map[string]bool `json:"can,omitempty"`              // Operations the current user is able to perform on this object
+}
+
+// List all datagroups.
+func (s *DatagroupsResourceOp) List(ctx context.Context) ([]Datagroup, *Response, error) {
+	return doList(ctx, s.client, DatagroupsBasePath, nil, new([]Datagroup))
+}
+
+// Get a datagroup by ID.
+func (s *DatagroupsResourceOp) Get(ctx context.Context, id string) (*Datagroup, *Response, error) {
+	return doGetById(ctx, s.client, DatagroupsBasePath, id, new(Datagroup))
+}
+
+// Update the StaleBefore and TriggeredAt timestamps of a datagroup. Setting TriggeredAt to the current time triggers
+// the datagroup, which rebuilds the PDTs using it and invalidates its cache entries.
+func (s *DatagroupsResourceOp) Update(ctx context.Context, id string, datagroup *Datagroup) (*Datagroup, *Response, error) {
+	return doUpdate(ctx, s.client, DatagroupsBasePath, id, &Datagroup{StaleBefore: datagroup.StaleBefore, TriggeredAt: datagroup.TriggeredAt}, new(Datagroup))
+}
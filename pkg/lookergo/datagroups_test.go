@@ -0,0 +1,63 @@
+package lookergo
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDatagroupsResourceOp_Update(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/4.0/datagroups/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPatch)
+		raw, _ := io.ReadAll(r.Body)
+		if body := strings.TrimSpace(string(raw)); body != `{"triggered_at":1700000000}` {
+			t.Errorf("request body = %s, expected only triggered_at", body)
+		}
+		fmt.Fprint(w, `{"id":"3","model_name":"sales","name":"etl","triggered_at":1700000000}`)
+	})
+
+	datagroup, _, err := client.Datagroups.Update(ctx, "3", &Datagroup{Id: "3", Name: "etl", TriggeredAt: 1700000000})
+	if err != nil {
+		t.Fatalf("Datagroups.Update returned error: %v", err)
+	}
+
+	expected := &Datagroup{Id: "3", ModelName: "sales", Name: "etl", TriggeredAt: 1700000000}
+	if !reflect.DeepEqual(datagroup, expected) {
+		t.Error(errGotWant("Datagroups.Update", datagroup, expected))
+	}
+}
+
+func TestDerivedTablesResourceOp_StartPDTBuild(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/4.0/derived_table/sales/orders_pdt/start", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testFormValues(t, r, values{"force_rebuild": "true", "source": "terraform"})
+		fmt.Fprint(w, `{"materialization_id":"abc","resp_text":"started"}`)
+	})
+	mux.HandleFunc("/4.0/derived_table/abc/status", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"materialization_id":"abc","resp_text":"done"}`)
+	})
+
+	build, _, err := client.DerivedTables.StartPDTBuild(ctx, "sales", "orders_pdt", &PDTBuildOptions{ForceRebuild: true, Source: "terraform"})
+	if err != nil {
+		t.Fatalf("DerivedTables.StartPDTBuild returned error: %v", err)
+	}
+	status, _, err := client.DerivedTables.CheckPDTBuild(ctx, build.MaterializationId)
+	if err != nil {
+		t.Fatalf("DerivedTables.CheckPDTBuild returned error: %v", err)
+	}
+
+	expected := &MaterializePDT{MaterializationId: "abc", RespText: "done"}
+	if !reflect.DeepEqual(status, expected) {
+		t.Error(errGotWant("DerivedTables.CheckPDTBuild", status, expected))
+	}
+}
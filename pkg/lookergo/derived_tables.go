@@ -0,0 +1,57 @@
+package lookergo
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+const DerivedTablesBasePath = "4.0/derived_table"
+
+// DerivedTablesResource is an interface for building persistent derived tables (PDTs) on demand. It requires PDT API
+// control to be enabled on the connection of the model (DBConnection.PdtApiControlEnabled).
+// Ref: https://developers.looker.com/api/explorer/4.0/methods/DerivedTable
+type DerivedTablesResource interface {
+	StartPDTBuild(ctx context.Context, modelName string, viewName string, opt *PDTBuildOptions) (*MaterializePDT, *Response, error)
+	CheckPDTBuild(ctx context.Context, materializationId string) (*MaterializePDT, *Response, error)
+	StopPDTBuild(ctx context.Context, materializationId string) (*MaterializePDT, *Response, error)
+}
+
+type DerivedTablesResourceOp struct {
+	client *Client
+}
+
+var _ DerivedTablesResource = &DerivedTablesResourceOp{}
+
+// PDTBuildOptions are the options of StartPDTBuild.
+type PDTBuildOptions struct {
+	ForceRebuild         bool   `url:"force_rebuild,omitempty"`          // Rebuild the PDT and the PDTs it depends on, even if they are not stale
+	ForceFullIncremental bool   `url:"force_full_incremental,omitempty"` // Rebuild incremental PDTs fully
+	Workspace            string `url:"workspace,omitempty"`              // "production" (default) or "dev"
+	Source               string `url:"source,omitempty"`                 // Name of the caller, shown in the PDT logs
+}
+
+// MaterializePDT is the status of a PDT build.
+type MaterializePDT struct {
+	MaterializationId string `json:"materialization_id,omitempty"` // ID of the build, to check or stop it
+	RespText          string `json:"resp_text,omitempty"`          // Status of the build
+}
+
+// StartPDTBuild starts building the PDT of viewName in modelName, along with the PDTs it depends on.
+func (s *DerivedTablesResourceOp) StartPDTBuild(ctx context.Context, modelName string, viewName string, opt *PDTBuildOptions) (*MaterializePDT, *Response, error) {
+	path, err := addOptions(fmt.Sprintf("%s/%s/%s/start", DerivedTablesBasePath, url.PathEscape(modelName), url.PathEscape(viewName)), opt)
+	if err != nil {
+		return nil, nil, err
+	}
+	return doGet(ctx, s.client, path, new(MaterializePDT))
+}
+
+// CheckPDTBuild returns the status of a PDT build started by StartPDTBuild.
+func (s *DerivedTablesResourceOp) CheckPDTBuild(ctx context.Context, materializationId string) (*MaterializePDT, *Response, error) {
+	return doGet(ctx, s.client, DerivedTablesBasePath, new(MaterializePDT), url.PathEscape(materializationId), "status")
+}
+
+// StopPDTBuild stops a PDT build started by StartPDTBuild.
+func (s *DerivedTablesResourceOp) StopPDTBuild(ctx context.Context, materializationId string) (*MaterializePDT, *Response, error) {
+	return doGet(ctx, s.client, DerivedTablesBasePath, new(MaterializePDT), url.PathEscape(materializationId), "stop")
+}
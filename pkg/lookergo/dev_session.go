@@ -0,0 +1,169 @@
+package lookergo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// DefaultDevSessionExpiryDelta is how long before its token expires a DevSession logs in again.
+const DefaultDevSessionExpiryDelta = time.Minute
+
+// DevSession is the token source of a client acting as a user in the dev workspace. Its tokens are obtained by the
+// parent client through 4.0/login/{user_id}, and each of them is a new API session which is switched to the dev
+// workspace before use. A token is replaced ExpiryDelta before it expires; the session it replaces is left to expire
+// within ExpiryDelta, as requests may still be in flight with it. A DevSession is safe for concurrent use.
+type DevSession struct {
+	// ExpiryDelta is how long before its token expires the session logs in again.
+	ExpiryDelta time.Duration
+
+	parent *Client
+	userId string
+
+	mu      sync.Mutex
+	token   *oauth2.Token
+	session *Session
+}
+
+// openDevSessions are the dev sessions logged in and not logged out yet, which CloseDevSessions logs out.
+var (
+	openDevSessionsMu sync.Mutex
+	openDevSessions   = map[*DevSession]struct{}{}
+)
+
+// NewDevSession returns a DevSession logging in as userId through parent. It doesn't log in until a token is needed.
+func NewDevSession(parent *Client, userId string) *DevSession {
+	return &DevSession{ExpiryDelta: DefaultDevSessionExpiryDelta, parent: parent, userId: userId}
+}
+
+// TokenContext returns the token of the current session, logging in anew with ctx if there is none or it expires
+// within ExpiryDelta.
+func (s *DevSession) TokenContext(ctx context.Context) (*oauth2.Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != nil && (s.token.Expiry.IsZero() || time.Until(s.token.Expiry) > s.ExpiryDelta) {
+		return s.token, nil
+	}
+	if err := s.login(ctx); err != nil {
+		return nil, err
+	}
+	return s.token, nil
+}
+
+// Logout ends the current session, if any. A later request logs in again.
+func (s *DevSession) Logout(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token == nil {
+		return nil
+	}
+	_, err := s.tokenClient(s.token).Sessions.Logout(ctx)
+	s.token, s.session = nil, nil
+
+	openDevSessionsMu.Lock()
+	delete(openDevSessions, s)
+	openDevSessionsMu.Unlock()
+	return err
+}
+
+// login replaces the current session by a new one in the dev workspace. The caller must hold s.mu.
+func (s *DevSession) login(ctx context.Context) error {
+	token, _, err := s.parent.Sessions.GetLoginUserToken(ctx, s.userId)
+	if err != nil {
+		return err
+	}
+
+	session, _, err := s.tokenClient(token).Sessions.SetWorkspaceId(ctx, "dev")
+	if err != nil {
+		return err
+	} else if session.WorkspaceId != "dev" {
+		return fmt.Errorf("did not find dev workspace")
+	}
+
+	s.token, s.session = token, session
+
+	openDevSessionsMu.Lock()
+	openDevSessions[s] = struct{}{}
+	openDevSessionsMu.Unlock()
+	return nil
+}
+
+// tokenClient returns a client of the parent API authenticated with token.
+func (s *DevSession) tokenClient(token *oauth2.Token) *Client {
+	c := NewClient(&http.Client{
+		Transport: &oauth2.Transport{Source: oauth2.StaticTokenSource(token), Base: s.parent.baseTransport()},
+		Timeout:   s.parent.timeout(),
+	})
+	c.BaseURL = s.parent.BaseURL
+	c.UserAgent = s.parent.UserAgent
+	c.retryPolicy = s.parent.retryPolicy
+	return c
+}
+
+// devSessionTransport authenticates the requests it sends with the tokens of a DevSession, logging in with the
+// context of the request when a new token is needed.
+type devSessionTransport struct {
+	session *DevSession
+	base    http.RoundTripper
+}
+
+func (t *devSessionTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.session.TokenContext(req.Context())
+	if err != nil {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, err
+	}
+
+	authReq := req.Clone(req.Context())
+	token.SetAuthHeader(authReq)
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return base.RoundTrip(authReq)
+}
+
+// useDevSession makes c send its requests with the tokens of session.
+func (c *Client) useDevSession(session *DevSession) {
+	c.client = &http.Client{Transport: &devSessionTransport{session: session, base: c.baseTransport()}, Timeout: c.timeout()}
+	c.devSession = session
+}
+
+// Close logs out the dev session of the client, if any. Logging out is best-effort: a session which is not logged out
+// expires on its own on the instance.
+func (c *Client) Close(ctx context.Context) error {
+	c.mu.Lock()
+	session := c.devSession
+	c.mu.Unlock()
+
+	if session == nil {
+		return nil
+	}
+	return session.Logout(ctx)
+}
+
+// CloseDevSessions logs out all the dev sessions of the process which are still logged in, and returns the last error
+// met. It is meant to be called once the clients are no longer used.
+func CloseDevSessions(ctx context.Context) (err error) {
+	openDevSessionsMu.Lock()
+	sessions := make([]*DevSession, 0, len(openDevSessions))
+	for s := range openDevSessions {
+		sessions = append(sessions, s)
+	}
+	openDevSessionsMu.Unlock()
+
+	for _, s := range sessions {
+		if logoutErr := s.Logout(ctx); logoutErr != nil {
+			err = logoutErr
+		}
+	}
+	return err
+}
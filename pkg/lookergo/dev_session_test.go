@@ -0,0 +1,208 @@
+package lookergo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+// setupDevSession serves logins of user 60, numbering their tokens t1, t2..., and records the requests made with each
+// token in calls. It returns a dev client using the tokens.
+func setupDevSession(t *testing.T, calls *[]string) *Client {
+	var mu sync.Mutex
+	record := func(r *http.Request, call string) {
+		mu.Lock()
+		defer mu.Unlock()
+		*calls = append(*calls, r.Header.Get("Authorization")+" "+call)
+	}
+
+	logins := 0
+	mux.HandleFunc("/4.0/login/60", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		mu.Lock()
+		logins++
+		token := fmt.Sprintf("t%d", logins)
+		mu.Unlock()
+		fmt.Fprintf(w, `{"access_token":%q,"token_type":"Bearer","expires_in":3600}`, token)
+	})
+	mux.HandleFunc("/4.0/session", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPatch)
+		record(r, "dev")
+		fmt.Fprint(w, `{"workspace_id":"dev"}`)
+	})
+	mux.HandleFunc("/4.0/user", func(w http.ResponseWriter, r *http.Request) {
+		record(r, "user")
+		fmt.Fprint(w, `{"id":"60"}`)
+	})
+	mux.HandleFunc("/4.0/logout", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		record(r, "logout")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	devClient := NewClient(nil)
+	devClient.BaseURL = client.BaseURL
+	if err := devClient.EnsureStaticToken(ctx, client, "60"); err != nil {
+		t.Fatalf("EnsureStaticToken returned error: %v", err)
+	}
+	return devClient
+}
+
+func TestDevSession_Refresh(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var calls []string
+	devClient := setupDevSession(t, &calls)
+	if devClient.Workspace != "dev" {
+		t.Errorf("Workspace = %q, expected dev", devClient.Workspace)
+	}
+
+	if _, _, err := devClient.Sessions.GetCurrentUser(ctx); err != nil {
+		t.Fatalf("Sessions.GetCurrentUser returned error: %v", err)
+	}
+	// The token expires within the expiry delta: the next request logs in again.
+	devClient.devSession.token.Expiry = time.Now().Add(DefaultDevSessionExpiryDelta / 2)
+	if _, _, err := devClient.Sessions.GetCurrentUser(ctx); err != nil {
+		t.Fatalf("Sessions.GetCurrentUser returned error: %v", err)
+	}
+
+	expected := []string{"Bearer t1 dev", "Bearer t1 user", "Bearer t2 dev", "Bearer t2 user"}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Error(errGotWant("calls", calls, expected))
+	}
+}
+
+func TestDevSession_Concurrent(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var calls []string
+	devClient := setupDevSession(t, &calls)
+	devClient.devSession.token.Expiry = time.Now()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, _, err := devClient.Sessions.GetCurrentUser(ctx); err != nil {
+				t.Errorf("Sessions.GetCurrentUser returned error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// A single new session for all the requests
+	logins := map[string]int{}
+	for _, call := range calls {
+		logins[call]++
+	}
+	expected := map[string]int{"Bearer t1 dev": 1, "Bearer t2 dev": 1, "Bearer t2 user": 10}
+	if !reflect.DeepEqual(logins, expected) {
+		t.Error(errGotWant("calls", logins, expected))
+	}
+}
+
+func TestDevSession_Close(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var calls []string
+	devClient := setupDevSession(t, &calls)
+
+	for i := 0; i < 2; i++ {
+		if err := devClient.Close(ctx); err != nil {
+			t.Fatalf("Close returned error: %v", err)
+		}
+	}
+
+	expected := []string{"Bearer t1 dev", "Bearer t1 logout"}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Error(errGotWant("calls", calls, expected))
+	}
+
+	// Closing a client without dev session does nothing
+	if err := client.Close(ctx); err != nil {
+		t.Errorf("Close returned error: %v", err)
+	}
+}
+
+func TestDevSession_Replace(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var calls []string
+	devClient := setupDevSession(t, &calls)
+	mux.HandleFunc("/4.0/login/61", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{"access_token":"u61","token_type":"Bearer","expires_in":3600}`)
+	})
+
+	// Same user: the session is kept
+	if err := devClient.EnsureStaticToken(ctx, client, "60"); err != nil {
+		t.Fatalf("EnsureStaticToken returned error: %v", err)
+	}
+	// Another user: the session is replaced and logged out
+	if err := devClient.EnsureStaticToken(ctx, client, "61"); err != nil {
+		t.Fatalf("EnsureStaticToken returned error: %v", err)
+	}
+
+	expected := []string{"Bearer t1 dev", "Bearer u61 dev", "Bearer t1 logout"}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Error(errGotWant("calls", calls, expected))
+	}
+}
+
+func TestDevSession_RequestContext(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var calls []string
+	devClient := setupDevSession(t, &calls)
+	devClient.devSession.token.Expiry = time.Now()
+
+	// The new token is requested with the context of the request, which is canceled
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, _, err := devClient.Sessions.GetCurrentUser(canceled); !errors.Is(err, context.Canceled) {
+		t.Errorf("Sessions.GetCurrentUser returned error %v, expected %v", err, context.Canceled)
+	}
+
+	expected := []string{"Bearer t1 dev"}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Error(errGotWant("calls", calls, expected))
+	}
+}
+
+func TestCloseDevSessions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	// Forget the sessions of other tests, whose servers are gone
+	openDevSessionsMu.Lock()
+	openDevSessions = map[*DevSession]struct{}{}
+	openDevSessionsMu.Unlock()
+
+	var calls []string
+	setupDevSession(t, &calls)
+
+	for i := 0; i < 2; i++ {
+		if err := CloseDevSessions(ctx); err != nil {
+			t.Fatalf("CloseDevSessions returned error: %v", err)
+		}
+	}
+
+	expected := []string{"Bearer t1 dev", "Bearer t1 logout"}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Error(errGotWant("calls", calls, expected))
+	}
+	if len(openDevSessions) != 0 {
+		t.Errorf("%d dev sessions still open, expected none", len(openDevSessions))
+	}
+}
@@ -1,6 +1,10 @@
 package lookergo
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
 
 // ArgError is an error that represents an error with an input to pkg. It
 // identifies the argument and the cause (if possible).
@@ -22,3 +26,49 @@ func NewArgError(arg, reason string) *ArgError {
 func (e *ArgError) Error() string {
 	return fmt.Sprintf("%s is invalid because %s", e.arg, e.reason)
 }
+
+// Sentinel errors matching an ErrorResponse with errors.Is, based on its HTTP status code.
+var (
+	// ErrNotFound matches 404 Not Found responses.
+	ErrNotFound = errors.New("not found")
+	// ErrConflict matches 409 Conflict responses.
+	ErrConflict = errors.New("conflict")
+	// ErrValidation matches 422 Unprocessable Entity responses, returned when the request body fails validation.
+	ErrValidation = errors.New("validation failed")
+)
+
+// StatusCode returns the HTTP status code of the response that caused the error.
+func (r *ErrorResponse) StatusCode() int {
+	if r.Response == nil {
+		return 0
+	}
+	return r.Response.StatusCode
+}
+
+// Is allows matching an ErrorResponse against ErrNotFound, ErrConflict and ErrValidation with errors.Is.
+func (r *ErrorResponse) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return r.StatusCode() == http.StatusNotFound
+	case ErrConflict:
+		return r.StatusCode() == http.StatusConflict
+	case ErrValidation:
+		return r.StatusCode() == http.StatusUnprocessableEntity
+	}
+	return false
+}
+
+// IsNotFound returns true if err is, or wraps, an ErrorResponse with status 404 Not Found.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// IsConflict returns true if err is, or wraps, an ErrorResponse with status 409 Conflict.
+func IsConflict(err error) bool {
+	return errors.Is(err, ErrConflict)
+}
+
+// IsValidation returns true if err is, or wraps, an ErrorResponse with status 422 Unprocessable Entity.
+func IsValidation(err error) bool {
+	return errors.Is(err, ErrValidation)
+}
@@ -12,6 +12,7 @@ const FoldersBasePath = "4.0/folders"
 type FoldersResource interface {
 	List(context.Context, *ListOptions) ([]Folder, *Response, error)
 	ListByName(context.Context, string, *ListOptions) ([]Folder, *Response, error)
+	Search(context.Context, *FolderSearch, *ListOptions) ([]Folder, *Response, error)
 	Get(context.Context, string) (*Folder, *Response, error)
 	//Get(context.Context,*ListOptions, string) ([]Folder, *Response, error)
 	Create(context.Context, *Folder) (*Folder, *Response, error)
@@ -68,24 +69,6 @@ type DashboardBase struct {
 	PreferredViewer    string          `json:"preferred_viewer,omitempty"` // The preferred route for viewing this dashboard (ie: dashboards or dashboards-next)
 }
 
-type Dashboard struct {
-	ContentFavoriteId  string     `json:"content_favorite_id,omitempty"`
-	ContentMetadataId  string     `json:"content_metadata_id,omitempty"`
-	Description        string     `json:"description,omitempty"`
-	Hidden             bool       `json:"hidden,omitempty"`
-	Id                 string     `json:"id,omitempty"`
-	Model              *LookModel `json:"model,omitempty"`
-	QueryTimezone      string     `json:"query_timezone,omitempty"`
-	Readonly           bool       `json:"readonly,omitempty"`
-	RefreshInterval    string     `json:"refresh_interval,omitempty"`
-	RefreshIntervalToI int64      `json:"refresh_interval_to_i,omitempty"`
-	Folder             *Folder    `json:"folder,omitempty"`
-	Title              string     `json:"title,omitempty"`
-	UserId             string     `json:"user_id,omitempty"`
-	Slug               string     `json:"slug,omitempty"`
-	PreferredViewer    string     `json:"preferred_viewer,omitempty"`
-}
-
 type LookWithDashboards struct {
 	Can                      *map[string]bool `json:"can,omitempty"`                        // Operations the current user is able to perform on this object
 	ContentMetadataId        string           `json:"content_metadata_id,omitempty"`        // Id of content metadata
@@ -158,6 +141,22 @@ func (s *FoldersResourceOp) ListByName(ctx context.Context, name string, opt *Li
 	return doListByX(ctx, s.client, path, opt, new([]Folder), qs)
 }
 
+// FolderSearch filters the folders returned by Search. Name supports the wildcards % (any characters) and _ (any
+// single character).
+type FolderSearch struct {
+	Name         string `url:"name,omitempty"`
+	ParentId     string `url:"parent_id,omitempty"`
+	CreatorId    string `url:"creator_id,omitempty"`
+	IsSharedRoot *bool  `url:"is_shared_root,omitempty"`
+	IsUsersRoot  *bool  `url:"is_users_root,omitempty"`
+	Sorts        string `url:"sorts,omitempty"`
+}
+
+// Search folders matching all the given filters. Without limit/offset options, all pages are retrieved.
+func (s *FoldersResourceOp) Search(ctx context.Context, search *FolderSearch, opt *ListOptions) ([]Folder, *Response, error) {
+	return doSearch(ctx, s.client, FoldersBasePath, search, opt, new([]Folder))
+}
+
 func (s *FoldersResourceOp) Get(ctx context.Context, FolderId string) (*Folder, *Response, error) {
 	return doGetById(ctx, s.client, FoldersBasePath, FolderId, new(Folder))
 }
@@ -16,6 +16,7 @@ type GroupsResource interface {
 	List(context.Context, *ListOptions) ([]Group, *Response, error)
 	ListByName(context.Context, string, *ListOptions) ([]Group, *Response, error)
 	ListById(context.Context, []int, *ListOptions) ([]Group, *Response, error)
+	Search(context.Context, *GroupSearch, *ListOptions) ([]Group, *Response, error)
 	Get(context.Context, int) (*Group, *Response, error)
 	Create(context.Context, *Group) (*Group, *Response, error)
 	Update(context.Context, int, *Group) (*Group, *Response, error)
@@ -91,6 +92,21 @@ func (s *GroupsResourceOp) ListById(ctx context.Context, ids []int, opt *ListOpt
 	return doListByX(ctx, s.client, path, opt, new([]Group), qs)
 }
 
+// GroupSearch filters the groups returned by Search. Name supports the wildcards % (any characters) and _ (any
+// single character).
+type GroupSearch struct {
+	Name              string `url:"name,omitempty"`
+	ExternalGroupId   string `url:"external_group_id,omitempty"`
+	ExternallyManaged *bool  `url:"externally_managed,omitempty"`
+	Sorts             string `url:"sorts,omitempty"`
+}
+
+// Search groups matching all the given filters, with their parent groups. Without limit/offset options, all pages are
+// retrieved.
+func (s *GroupsResourceOp) Search(ctx context.Context, search *GroupSearch, opt *ListOptions) ([]Group, *Response, error) {
+	return doSearch(ctx, s.client, groupBasePath, search, opt, new([]Group), "with_hierarchy")
+}
+
 // Get a group by ID.
 func (s *GroupsResourceOp) Get(ctx context.Context, id int) (*Group, *Response, error) {
 	return doGetById(ctx, s.client, groupBasePath, id, new(Group))
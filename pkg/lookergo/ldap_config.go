@@ -0,0 +1,158 @@
+package lookergo
+
+import (
+	"context"
+	"net/http"
+)
+
+const LDAPConfigBasePath = "4.0/ldap_config"
+
+// LDAPConfigResource is an interface for interfacing with the LDAP authentication configuration endpoints of the API.
+// Ref: https://developers.looker.com/api/explorer/4.0/methods/Auth/ldap_config
+type LDAPConfigResource interface {
+	Get(ctx context.Context) (*LDAPConfig, *Response, error)
+	Update(ctx context.Context, config *LDAPConfig) (*LDAPConfig, *Response, error)
+	TestConnection(ctx context.Context, config *LDAPConfig) (*LDAPConfigTestResult, *Response, error)
+	TestAuth(ctx context.Context, config *LDAPConfig) (*LDAPConfigTestResult, *Response, error)
+	TestUserInfo(ctx context.Context, config *LDAPConfig) (*LDAPConfigTestResult, *Response, error)
+	TestUserAuth(ctx context.Context, config *LDAPConfig) (*LDAPConfigTestResult, *Response, error)
+}
+
+type LDAPConfigResourceOp struct {
+	client *Client
+}
+
+var _ LDAPConfigResource = &LDAPConfigResourceOp{}
+
+// LDAPConfig is the LDAP authentication configuration of the instance. Fields left nil are not changed by Update.
+type LDAPConfig struct {
+	AlternateEmailLoginAllowed *bool                       `json:"alternate_email_login_allowed,omitempty"`  // Allow alternate email-based login via '/login/email' for admins and for specified users with the 'login_special_email' permission. This option is useful as a fallback during ldap setup, if ldap config problems occur later, or if you need to support some users who are not in your ldap directory. Looker email/password logins are always disabled for regular users when ldap is enabled.
+	AuthPassword               *string                     `json:"auth_password,omitempty"`                  // (Write-Only)  Password for the LDAP account used to access the LDAP server
+	AuthRequiresRole           *bool                       `json:"auth_requires_role,omitempty"`             // Users will not be allowed to login at all unless a role for them is found in LDAP if set to true
+	AuthUsername               *string                     `json:"auth_username,omitempty"`                  // Distinguished name of LDAP account used to access the LDAP server
+	ConnectionHost             *string                     `json:"connection_host,omitempty"`                // LDAP server hostname
+	ConnectionPort             *string                     `json:"connection_port,omitempty"`                // LDAP host port
+	ConnectionTls              *bool                       `json:"connection_tls,omitempty"`                 // Use Transport Layer Security
+	ConnectionTlsNoVerify      *bool                       `json:"connection_tls_no_verify,omitempty"`       // Do not verify peer when using TLS
+	DefaultNewUserGroupIds     *[]string                   `json:"default_new_user_group_ids,omitempty"`     // (Write-Only)  Array of ids of groups that will be applied to new users the first time they login via LDAP
+	DefaultNewUserGroups       *[]Group                    `json:"default_new_user_groups,omitempty"`        // (Read-only) Groups that will be applied to new users the first time they login via LDAP
+	DefaultNewUserRoleIds      *[]string                   `json:"default_new_user_role_ids,omitempty"`      // (Write-Only)  Array of ids of roles that will be applied to new users the first time they login via LDAP
+	DefaultNewUserRoles        *[]Role                     `json:"default_new_user_roles,omitempty"`         // (Read-only) Roles that will be applied to new users the first time they login via LDAP
+	Enabled                    *bool                       `json:"enabled,omitempty"`                        // Enable/Disable LDAP authentication for the server
+	ForceNoPage                *bool                       `json:"force_no_page,omitempty"`                  // Don't attempt to do LDAP search result paging (RFC 2696) even if the LDAP server claims to support it.
+	GroupsBaseDn               *string                     `json:"groups_base_dn,omitempty"`                 // Base dn for finding groups in LDAP searches
+	GroupsFinderType           *string                     `json:"groups_finder_type,omitempty"`             // Identifier for a strategy for how Looker will search for groups in the LDAP server
+	GroupsMemberAttribute      *string                     `json:"groups_member_attribute,omitempty"`        // LDAP Group attribute that signifies the members of the groups. Most commonly 'member'
+	GroupsObjectclasses        *string                     `json:"groups_objectclasses,omitempty"`           // Optional comma-separated list of supported LDAP objectclass for groups when doing groups searches
+	GroupsUserAttribute        *string                     `json:"groups_user_attribute,omitempty"`          // LDAP Group attribute that signifies the user in a group. Most commonly 'dn'
+	GroupsWithRoleIds          *[]AuthGroupMapping         `json:"groups_with_role_ids,omitempty"`           // (Read/Write) Array of mappings between LDAP Groups and arrays of Looker Role ids
+	HasAuthPassword            *bool                       `json:"has_auth_password,omitempty"`              // (Read-only) Has the password been set for the LDAP account used to access the LDAP server
+	MergeNewUsersByEmail       *bool                       `json:"merge_new_users_by_email,omitempty"`       // Merge first-time ldap login to existing user account by email addresses. When a user logs in for the first time via ldap this option will connect this user into their existing account by finding the account with a matching email address. Otherwise a new user account will be created for the user.
+	ModifiedAt                 *string                     `json:"modified_at,omitempty"`                    // When this config was last modified (read-only)
+	ModifiedBy                 *string                     `json:"modified_by,omitempty"`                    // User id of user who last modified this config (read-only)
+	SetRolesFromGroups         *bool                       `json:"set_roles_from_groups,omitempty"`          // Set user roles in Looker based on groups from LDAP
+	TestLdapPassword           *string                     `json:"test_ldap_password,omitempty"`             // (Write-Only)  Test LDAP user password. For ldap tests only.
+	TestLdapUser               *string                     `json:"test_ldap_user,omitempty"`                 // (Write-Only)  Test LDAP user login id. For ldap tests only.
+	UserAttributeMapEmail      *string                     `json:"user_attribute_map_email,omitempty"`       // Name of user record attributes used to indicate email address field
+	UserAttributeMapFirstName  *string                     `json:"user_attribute_map_first_name,omitempty"`  // Name of user record attributes used to indicate first name
+	UserAttributeMapLastName   *string                     `json:"user_attribute_map_last_name,omitempty"`   // Name of user record attributes used to indicate last name
+	UserAttributeMapLdapId     *string                     `json:"user_attribute_map_ldap_id,omitempty"`     // Name of user record attributes used to indicate unique record id
+	UserAttributesWithIds      *[]AuthUserAttributeMapping `json:"user_attributes_with_ids,omitempty"`       // (Read/Write) Array of mappings between LDAP User Attributes and arrays of Looker User Attribute ids
+	UserBindBaseDn             *string                     `json:"user_bind_base_dn,omitempty"`              // Distinguished name of LDAP node used as the base for user searches
+	UserCustomFilter           *string                     `json:"user_custom_filter,omitempty"`             // (Optional) Custom RFC-2254 filter clause for use in finding user during login. Combined via 'and' with the other generated filter clauses.
+	UserIdAttributeNames       *string                     `json:"user_id_attribute_names,omitempty"`        // Name(s) of user record attributes used for matching user login id (comma separated list)
+	UserObjectclass            *string                     `json:"user_objectclass,omitempty"`               // (Optional) Name of user record objectclass used for finding user during login id
+	AllowNormalGroupMembership *bool                       `json:"allow_normal_group_membership,omitempty"`  // Allow LDAP auth'd users to be members of non-reflected Looker groups. If 'false', user will be removed from non-reflected groups on login.
+	AllowRolesFromNormalGroups *bool                       `json:"allow_roles_from_normal_groups,omitempty"` // LDAP auth'd users will be able to inherit roles from non-reflected Looker groups.
+	AllowDirectRoles           *bool                       `json:"allow_direct_roles,omitempty"`             // Allows roles to be directly assigned to LDAP auth'd users.
+}
+
+// LDAPConfigTestResult is the outcome of testing an LDAP configuration.
+type LDAPConfigTestResult struct {
+	Details string                `json:"details,omitempty"` // Additional details for error cases
+	Issues  []LDAPConfigTestIssue `json:"issues,omitempty"`  // Array of issues/considerations about the result
+	Message string                `json:"message,omitempty"` // Short human readable test about the result
+	Status  string                `json:"status,omitempty"`  // Test status code: always 'success' or 'error'
+	Trace   string                `json:"trace,omitempty"`   // A more detailed trace of incremental results during auth tests
+	User    *LDAPUser             `json:"user,omitempty"`
+}
+
+// LDAPConfigTestIssue is an issue found while testing an LDAP configuration.
+type LDAPConfigTestIssue struct {
+	Severity string `json:"severity,omitempty"` // Severity of the issue. Error or Warning
+	Message  string `json:"message,omitempty"`  // Message describing the issue
+}
+
+// LDAPUser is the user found in the LDAP directory by TestUserInfo and TestUserAuth.
+type LDAPUser struct {
+	AllEmails []string `json:"all_emails,omitempty"` // Array of user's email addresses and aliases for use in migration
+	Email     string   `json:"email,omitempty"`      // Primary email address
+	FirstName string   `json:"first_name,omitempty"` // First name
+	Groups    []string `json:"groups,omitempty"`     // Array of user's groups (group names only)
+	LastName  string   `json:"last_name,omitempty"`  // Last Name
+	LdapDn    string   `json:"ldap_dn,omitempty"`    // LDAP's distinguished name for the user record
+	LdapId    string   `json:"ldap_id,omitempty"`    // LDAP's Unique ID for the user
+	Roles     []string `json:"roles,omitempty"`      // Array of user's roles (role names only)
+}
+
+// Values of LDAPConfigTestResult.Status and LDAPConfigTestIssue.Severity.
+const (
+	LDAPConfigTestStatus_SUCCESS   = "success"
+	LDAPConfigTestStatus_ERROR     = "error"
+	LDAPConfigTestSeverity_ERROR   = "error"
+	LDAPConfigTestSeverity_WARNING = "warning"
+)
+
+// Get returns the LDAP configuration.
+func (s *LDAPConfigResourceOp) Get(ctx context.Context) (*LDAPConfig, *Response, error) {
+	return doGet(ctx, s.client, LDAPConfigBasePath, new(LDAPConfig))
+}
+
+// Update changes the non-nil fields of the LDAP configuration.
+func (s *LDAPConfigResourceOp) Update(ctx context.Context, config *LDAPConfig) (*LDAPConfig, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPatch, LDAPConfigBasePath, config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updated := new(LDAPConfig)
+	resp, err := s.client.Do(ctx, req, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+	return updated, resp, err
+}
+
+// TestConnection tests whether the LDAP server of config can be reached.
+func (s *LDAPConfigResourceOp) TestConnection(ctx context.Context, config *LDAPConfig) (*LDAPConfigTestResult, *Response, error) {
+	return s.test(ctx, "test_connection", config)
+}
+
+// TestAuth tests whether the LDAP account of config can authenticate.
+func (s *LDAPConfigResourceOp) TestAuth(ctx context.Context, config *LDAPConfig) (*LDAPConfigTestResult, *Response, error) {
+	return s.test(ctx, "test_auth", config)
+}
+
+// TestUserInfo tests whether the user set in TestLdapUser can be found, and returns its information.
+func (s *LDAPConfigResourceOp) TestUserInfo(ctx context.Context, config *LDAPConfig) (*LDAPConfigTestResult, *Response, error) {
+	return s.test(ctx, "test_user_info", config)
+}
+
+// TestUserAuth tests whether the user set in TestLdapUser can log in with TestLdapPassword.
+func (s *LDAPConfigResourceOp) TestUserAuth(ctx context.Context, config *LDAPConfig) (*LDAPConfigTestResult, *Response, error) {
+	return s.test(ctx, "test_user_auth", config)
+}
+
+func (s *LDAPConfigResourceOp) test(ctx context.Context, test string, config *LDAPConfig) (*LDAPConfigTestResult, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPut, LDAPConfigBasePath+"/"+test, config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(LDAPConfigTestResult)
+	resp, err := s.client.Do(ctx, req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return result, resp, err
+}
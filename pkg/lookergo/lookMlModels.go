@@ -5,7 +5,7 @@ import "context"
 const lookMlModelsBasePath = "4.0/lookml_models"
 
 type LookMlModelsResource interface {
-	List(ctx context.Context) ([]LookMLModel, *Response, error)
+	List(ctx context.Context, opt *ListOptions) ([]LookMLModel, *Response, error)
 	Get(ctx context.Context, LookMLModelName string) (*LookMLModel, *Response, error)
 	Create(ctx context.Context, LookMLModel *LookMLModel) (*LookMLModel, *Response, error)
 	Update(ctx context.Context, LookMLModelName string, LookMLModel *LookMLModel) (*LookMLModel, *Response, error)
@@ -31,10 +31,9 @@ type LookMLModel struct {
 	UnlimitedDbConnections   bool                     `json:"unlimited_db_connections,omitempty"`    // Is this model allowed to use all current and future connections
 }
 
-func (s LookMlModelsResourceOp) List(ctx context.Context) ([]LookMLModel, *Response, error) {
-	// TODO implement me
-	//return doList(ctx, s.client, lookMlModelsBasePath, )
-	panic("Not implemented")
+// List all models, with their explores. Without limit/offset options, all pages are retrieved.
+func (s LookMlModelsResourceOp) List(ctx context.Context, opt *ListOptions) ([]LookMLModel, *Response, error) {
+	return doList(ctx, s.client, lookMlModelsBasePath, opt, new([]LookMLModel))
 }
 
 func (s LookMlModelsResourceOp) Get(ctx context.Context, LookMLModelName string) (*LookMLModel, *Response, error) {
@@ -1,5 +1,12 @@
 package lookergo
 
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
 /*
 
 ### Get All LookMlModels
@@ -284,3 +291,26 @@ Authorization: token {{token}}
   },`
 
 */
+
+func TestLookMlModelsResourceOp_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/4.0/lookml_models", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[{"name":"cross_channel","project_name":"hub","has_content":true,"explores":[{"name":"sessions","label":"Sessions","hidden":false}]},{"name":"empty","project_name":"hub"}]`)
+	})
+
+	models, _, err := client.LookMLModel.List(ctx, nil)
+	if err != nil {
+		t.Fatalf("LookMLModel.List returned error: %v", err)
+	}
+
+	expected := []LookMLModel{
+		{Name: "cross_channel", ProjectName: "hub", HasContent: true, Explores: &[]LookmlModelNavExplore{{Name: String("sessions"), Label: String("Sessions"), Hidden: Bool(false)}}},
+		{Name: "empty", ProjectName: "hub"},
+	}
+	if !reflect.DeepEqual(models, expected) {
+		t.Error(errGotWant("LookMLModel.List", models, expected))
+	}
+}
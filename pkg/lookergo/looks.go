@@ -0,0 +1,139 @@
+package lookergo
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const LooksBasePath = "4.0/looks"
+
+type LooksResource interface {
+	List(context.Context, *ListOptions) ([]Look, *Response, error)
+	ListByTitle(context.Context, string, *ListOptions) ([]Look, *Response, error)
+	Get(context.Context, string) (*Look, *Response, error)
+	Create(context.Context, *Look) (*Look, *Response, error)
+	Update(context.Context, string, *Look) (*Look, *Response, error)
+	Delete(context.Context, string) (*Response, error)
+	Move(context.Context, string, string) (*Look, *Response, error)
+	Run(context.Context, string, string) (*string, *Response, error)
+}
+
+type LooksResourceOp struct {
+	client *Client
+}
+
+var _ LooksResource = &LooksResourceOp{}
+
+type Look struct {
+	Id                string      `json:"id,omitempty"`          // Unique Id
+	Title             string      `json:"title,omitempty"`       // Look Title
+	Description       *string     `json:"description,omitempty"` // Description
+	FolderId          string      `json:"folder_id,omitempty"`   // Folder Id
+	Folder            *FolderBase `json:"folder,omitempty"`
+	QueryId           string      `json:"query_id,omitempty"`            // Query Id
+	Query             *Query      `json:"query,omitempty"`               // Query, only returned by Get
+	IsRunOnLoad       *bool       `json:"is_run_on_load,omitempty"`      // auto-run query when Look viewed
+	Public            *bool       `json:"public,omitempty"`              // Is Public
+	Deleted           *bool       `json:"deleted,omitempty"`             // Whether or not a look is 'soft' deleted.
+	UserId            string      `json:"user_id,omitempty"`             // User Id
+	ContentMetadataId string      `json:"content_metadata_id,omitempty"` // Id of content metadata
+	Model             *LookModel  `json:"model,omitempty"`
+	ShortUrl          string      `json:"short_url,omitempty"`  // Short Url
+	PublicUrl         string      `json:"public_url,omitempty"` // Public Url
+}
+
+// Valid result formats of LooksResource.Run.
+const (
+	LookResultFormat_JSON   = "json"
+	LookResultFormat_CSV    = "csv"
+	LookResultFormat_TXT    = "txt"
+	LookResultFormat_SQL    = "sql"
+	LookResultFormat_MD     = "md"
+	LookResultFormat_XLSX   = "xlsx"
+	LookResultFormat_PNG    = "png"
+	LookResultFormat_JPG    = "jpg"
+	LookResultFormat_HTML   = "html"
+	LookResultFormat_JSONBI = "json_bi"
+)
+
+func (s *LooksResourceOp) List(ctx context.Context, opt *ListOptions) ([]Look, *Response, error) {
+	return doList(ctx, s.client, LooksBasePath, opt, new([]Look))
+}
+
+func (s *LooksResourceOp) ListByTitle(ctx context.Context, title string, opt *ListOptions) ([]Look, *Response, error) {
+	if title == "" {
+		return nil, nil, NewArgError("title", "has to be non-empty")
+	}
+	qs := url.Values{}
+	qs.Add("title", title)
+
+	path := fmt.Sprintf("%s/search", LooksBasePath)
+
+	return doListByX(ctx, s.client, path, opt, new([]Look), qs)
+}
+
+func (s *LooksResourceOp) Get(ctx context.Context, LookId string) (*Look, *Response, error) {
+	return doGetById(ctx, s.client, LooksBasePath, LookId, new(Look))
+}
+
+func (s *LooksResourceOp) Create(ctx context.Context, requestLook *Look) (*Look, *Response, error) {
+	return doCreate(ctx, s.client, LooksBasePath, requestLook, new(Look))
+}
+
+func (s *LooksResourceOp) Update(ctx context.Context, LookId string, requestLook *Look) (*Look, *Response, error) {
+	return doUpdate(ctx, s.client, LooksBasePath, LookId, requestLook, new(Look))
+}
+
+func (s *LooksResourceOp) Delete(ctx context.Context, LookId string) (*Response, error) {
+	return doDelete(ctx, s.client, LooksBasePath, LookId)
+}
+
+// Move moves the look to the folder with the given id.
+func (s *LooksResourceOp) Move(ctx context.Context, LookId string, FolderId string) (*Look, *Response, error) {
+	if FolderId == "" {
+		return nil, nil, NewArgError("FolderId", "has to be non-empty")
+	}
+	qs := url.Values{}
+	qs.Add("folder_id", FolderId)
+	path := fmt.Sprintf("%s/%s/move?%s", LooksBasePath, LookId, qs.Encode())
+
+	req, err := s.client.NewRequest(ctx, http.MethodPatch, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	look := new(Look)
+	resp, err := s.client.Do(ctx, req, look)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return look, resp, err
+}
+
+// Run runs the query of the look and returns the raw result in the given format, e.g. LookResultFormat_JSON.
+func (s *LooksResourceOp) Run(ctx context.Context, LookId string, ResultFormat string) (*string, *Response, error) {
+	if ResultFormat == "" {
+		return nil, nil, NewArgError("ResultFormat", "has to be non-empty")
+	}
+	path := fmt.Sprintf("%s/%s/run/%s", LooksBasePath, LookId, ResultFormat)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	// Results are not necessarily JSON.
+	req.Header.Set("Accept", "*/*")
+
+	var buf bytes.Buffer
+	resp, err := s.client.Do(ctx, req, &buf)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	result := buf.String()
+	return &result, resp, err
+}
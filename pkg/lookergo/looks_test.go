@@ -0,0 +1,83 @@
+package lookergo
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestLooksResourceOp_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/4.0/looks/7", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id":"7","title":"Revenue","folder_id":"34","query_id":"99",
+			"query":{"id":"99","model":"thelook","view":"order_items","fields":["order_items.created_date","order_items.total_sale_price"],
+			"filters":{"order_items.created_date":"30 days"},"limit":"500"}}`)
+	})
+
+	look, _, err := client.Looks.Get(ctx, "7")
+	if err != nil {
+		t.Fatalf("Looks.Get returned error: %v", err)
+	}
+
+	expected := &Look{
+		Id:       "7",
+		Title:    "Revenue",
+		FolderId: "34",
+		QueryId:  "99",
+		Query: &Query{
+			Id:      "99",
+			Model:   "thelook",
+			View:    "order_items",
+			Fields:  []string{"order_items.created_date", "order_items.total_sale_price"},
+			Filters: map[string]string{"order_items.created_date": "30 days"},
+			Limit:   String("500"),
+		},
+	}
+	if !reflect.DeepEqual(look, expected) {
+		t.Error(errGotWant("Looks.Get", look, expected))
+	}
+}
+
+func TestLooksResourceOp_Move(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/4.0/looks/7/move", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPatch)
+		testFormValues(t, r, values{"folder_id": "35"})
+		fmt.Fprint(w, `{"id":"7","title":"Revenue","folder_id":"35"}`)
+	})
+
+	look, _, err := client.Looks.Move(ctx, "7", "35")
+	if err != nil {
+		t.Fatalf("Looks.Move returned error: %v", err)
+	}
+	if look.FolderId != "35" {
+		t.Errorf("Looks.Move returned folder_id %q, expected %q", look.FolderId, "35")
+	}
+}
+
+func TestLooksResourceOp_Run(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/4.0/looks/7/run/csv", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		w.Header().Set("Content-Type", "text/csv")
+		fmt.Fprint(w, "date,revenue\n2023-01-01,100\n")
+	})
+
+	result, _, err := client.Looks.Run(ctx, "7", LookResultFormat_CSV)
+	if err != nil {
+		t.Fatalf("Looks.Run returned error: %v", err)
+	}
+
+	expected := "date,revenue\n2023-01-01,100\n"
+	if *result != expected {
+		t.Errorf("Looks.Run returned %q, expected %q", *result, expected)
+	}
+}
@@ -0,0 +1,104 @@
+package lookergo
+
+import (
+	"context"
+	"net/http"
+)
+
+const (
+	OIDCConfigBasePath     = "4.0/oidc_config"
+	OIDCTestConfigBasePath = "4.0/oidc_test_configs"
+)
+
+// OIDCConfigResource is an interface for interfacing with the OpenID Connect authentication configuration endpoints of
+// the API.
+// Ref: https://developers.looker.com/api/explorer/4.0/methods/Auth/oidc_config
+type OIDCConfigResource interface {
+	Get(ctx context.Context) (*OIDCConfig, *Response, error)
+	Update(ctx context.Context, config *OIDCConfig) (*OIDCConfig, *Response, error)
+	CreateTestConfig(ctx context.Context, config *OIDCConfig) (*OIDCConfig, *Response, error)
+	GetTestConfig(ctx context.Context, testSlug string) (*OIDCConfig, *Response, error)
+	DeleteTestConfig(ctx context.Context, testSlug string) (*Response, error)
+}
+
+type OIDCConfigResourceOp struct {
+	client *Client
+}
+
+var _ OIDCConfigResource = &OIDCConfigResourceOp{}
+
+// OIDCConfig is the OpenID Connect authentication configuration of the instance. Fields left nil are not changed by
+// Update.
+type OIDCConfig struct {
+	AlternateEmailLoginAllowed *bool                       `json:"alternate_email_login_allowed,omitempty"`  // Allow alternate email-based login via '/login/email' for admins and for specified users with the 'login_special_email' permission. This option is useful as a fallback during ldap setup, if ldap config problems occur later, or if you need to support some users who are not in your ldap directory. Looker email/password logins are always disabled for regular users when ldap is enabled.
+	Audience                   *string                     `json:"audience,omitempty"`                       // OpenID Provider Audience
+	AuthRequiresRole           *bool                       `json:"auth_requires_role,omitempty"`             // Users will not be allowed to login at all unless a role for them is found in OIDC if set to true
+	AuthorizationEndpoint      *string                     `json:"authorization_endpoint,omitempty"`         // OpenID Provider Authorization Url
+	DefaultNewUserGroupIds     *[]string                   `json:"default_new_user_group_ids,omitempty"`     // (Write-Only) Array of ids of groups that will be applied to new users the first time they login via OIDC
+	DefaultNewUserGroups       *[]Group                    `json:"default_new_user_groups,omitempty"`        // (Read-only) Groups that will be applied to new users the first time they login via OIDC
+	DefaultNewUserRoleIds      *[]string                   `json:"default_new_user_role_ids,omitempty"`      // (Write-Only) Array of ids of roles that will be applied to new users the first time they login via OIDC
+	DefaultNewUserRoles        *[]Role                     `json:"default_new_user_roles,omitempty"`         // (Read-only) Roles that will be applied to new users the first time they login via OIDC
+	Enabled                    *bool                       `json:"enabled,omitempty"`                        // Enable/Disable OIDC authentication for the server
+	GroupsAttribute            *string                     `json:"groups_attribute,omitempty"`               // Name of user record attributes used to indicate groups. Used when 'groups_finder_type' is set to 'grouped_attribute_values'
+	GroupsWithRoleIds          *[]AuthGroupMapping         `json:"groups_with_role_ids,omitempty"`           // (Read/Write) Array of mappings between OIDC Groups and arrays of Looker Role ids
+	Identifier                 *string                     `json:"identifier,omitempty"`                     // Relying Party Identifier (provided by OpenID Provider)
+	Issuer                     *string                     `json:"issuer,omitempty"`                         // OpenID Provider Issuer
+	ModifiedAt                 *string                     `json:"modified_at,omitempty"`                    // When this config was last modified (read-only)
+	ModifiedBy                 *string                     `json:"modified_by,omitempty"`                    // User id of user who last modified this config (read-only)
+	NewUserMigrationTypes      *string                     `json:"new_user_migration_types,omitempty"`       // Merge first-time oidc login to existing user account by email addresses. When a user logs in for the first time via oidc this option will connect this user into their existing account by finding the account with a matching email address by testing the given types of credentials for existing users. Otherwise a new user account will be created for the user. This list (if provided) must be a comma separated list of string like 'email,ldap,google'
+	Scopes                     *[]string                   `json:"scopes,omitempty"`                         // Array of scopes to request.
+	Secret                     *string                     `json:"secret,omitempty"`                         // (Write-Only) Relying Party Secret (provided by OpenID Provider)
+	SetRolesFromGroups         *bool                       `json:"set_roles_from_groups,omitempty"`          // Set user roles in Looker based on groups from OIDC
+	TestSlug                   *string                     `json:"test_slug,omitempty"`                      // Slug to identify configurations that are created in order to run a OIDC config test (read-only)
+	TokenEndpoint              *string                     `json:"token_endpoint,omitempty"`                 // OpenID Provider Token Url
+	UserAttributeMapEmail      *string                     `json:"user_attribute_map_email,omitempty"`       // Name of user record attributes used to indicate email address field
+	UserAttributeMapFirstName  *string                     `json:"user_attribute_map_first_name,omitempty"`  // Name of user record attributes used to indicate first name
+	UserAttributeMapLastName   *string                     `json:"user_attribute_map_last_name,omitempty"`   // Name of user record attributes used to indicate last name
+	UserAttributesWithIds      *[]AuthUserAttributeMapping `json:"user_attributes_with_ids,omitempty"`       // (Read/Write) Array of mappings between OIDC User Attributes and arrays of Looker User Attribute ids
+	UserinfoEndpoint           *string                     `json:"userinfo_endpoint,omitempty"`              // OpenID Provider User Information Url
+	AllowNormalGroupMembership *bool                       `json:"allow_normal_group_membership,omitempty"`  // Allow OIDC auth'd users to be members of non-reflected Looker groups. If 'false', user will be removed from non-reflected groups on login.
+	AllowRolesFromNormalGroups *bool                       `json:"allow_roles_from_normal_groups,omitempty"` // OIDC auth'd users will inherit roles from non-reflected Looker groups.
+	AllowDirectRoles           *bool                       `json:"allow_direct_roles,omitempty"`             // Allows roles to be directly assigned to OIDC auth'd users.
+}
+
+// Get returns the OpenID Connect configuration.
+func (s *OIDCConfigResourceOp) Get(ctx context.Context) (*OIDCConfig, *Response, error) {
+	return doGet(ctx, s.client, OIDCConfigBasePath, new(OIDCConfig))
+}
+
+// Update changes the non-nil fields of the OpenID Connect configuration.
+func (s *OIDCConfigResourceOp) Update(ctx context.Context, config *OIDCConfig) (*OIDCConfig, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPatch, OIDCConfigBasePath, config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updated := new(OIDCConfig)
+	resp, err := s.client.Do(ctx, req, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+	return updated, resp, err
+}
+
+// CreateTestConfig validates config without applying it. The returned configuration has a TestSlug, which can be used
+// to try logging in with it at /oidc_test_login?test_slug=...
+func (s *OIDCConfigResourceOp) CreateTestConfig(ctx context.Context, config *OIDCConfig) (*OIDCConfig, *Response, error) {
+	return doCreate(ctx, s.client, OIDCTestConfigBasePath, config, new(OIDCConfig))
+}
+
+// GetTestConfig returns a test configuration created by CreateTestConfig.
+func (s *OIDCConfigResourceOp) GetTestConfig(ctx context.Context, testSlug string) (*OIDCConfig, *Response, error) {
+	if testSlug == "" {
+		return nil, nil, NewArgError("testSlug", "cannot be empty")
+	}
+	return doGet(ctx, s.client, OIDCTestConfigBasePath, new(OIDCConfig), testSlug)
+}
+
+// DeleteTestConfig deletes a test configuration created by CreateTestConfig.
+func (s *OIDCConfigResourceOp) DeleteTestConfig(ctx context.Context, testSlug string) (*Response, error) {
+	if testSlug == "" {
+		return nil, NewArgError("testSlug", "cannot be empty")
+	}
+	return doDelete(ctx, s.client, OIDCTestConfigBasePath, testSlug)
+}
@@ -0,0 +1,178 @@
+package lookergo
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+)
+
+// Pagination is provided by the API in the response headers:
+//
+//	GET {{endpoint}}/4.0/users?limit=5
+//	(H) X-Total-Count: 107
+//	(H) Link: <https://x.cloud.looker.com:19999/api/4.0/users?limit=5&offset=0>; rel="first",
+//	          <https://x.cloud.looker.com:19999/api/4.0/users?limit=5&offset=105>; rel="last",
+//	          <https://x.cloud.looker.com:19999/api/4.0/users?limit=5&offset=5>; rel="next"
+const (
+	headerTotalCount = "X-Total-Count"
+	headerLink       = "Link"
+)
+
+// linkRe matches a single `<url>; rel="name"` entry of an RFC 5988 Link header.
+var linkRe = regexp.MustCompile(`<([^>]*)>\s*;\s*rel="?([^",;]*)"?`)
+
+// Links holds the pagination links of a list response, as found in the Link header.
+type Links struct {
+	First string
+	Prev  string
+	Next  string
+	Last  string
+}
+
+// IsLastPage returns true if there is no next page to retrieve.
+func (l Links) IsLastPage() bool {
+	return l.Next == ""
+}
+
+// NextPageOptions returns the ListOptions that retrieve the next page, or nil if this is the last page.
+func (l Links) NextPageOptions() (*ListOptions, error) {
+	if l.IsLastPage() {
+		return nil, nil
+	}
+
+	return listOptionsFromURL(l.Next)
+}
+
+// parseLinks parses an RFC 5988 Link header into Links. Unknown relations are ignored.
+func parseLinks(header string) Links {
+	var links Links
+	for _, match := range linkRe.FindAllStringSubmatch(header, -1) {
+		switch match[2] {
+		case "first":
+			links.First = match[1]
+		case "prev", "previous":
+			links.Prev = match[1]
+		case "next":
+			links.Next = match[1]
+		case "last":
+			links.Last = match[1]
+		}
+	}
+	return links
+}
+
+// populatePageValues fills the pagination fields of the Response from its headers.
+func (r *Response) populatePageValues() {
+	if r.Response == nil {
+		return
+	}
+
+	if total := r.Header.Get(headerTotalCount); total != "" {
+		if n, err := strconv.Atoi(total); err == nil {
+			r.TotalCount = n
+		}
+	}
+
+	r.Links = parseLinks(r.Header.Get(headerLink))
+}
+
+// listOptionsFromURL extracts the limit/offset querystring of a pagination link.
+func listOptionsFromURL(link string) (*ListOptions, error) {
+	u, err := url.Parse(link)
+	if err != nil {
+		return nil, err
+	}
+
+	opt := &ListOptions{}
+	q := u.Query()
+	if limit := q.Get("limit"); limit != "" {
+		if opt.Limit, err = strconv.Atoi(limit); err != nil {
+			return nil, err
+		}
+	}
+	if offset := q.Get("offset"); offset != "" {
+		if opt.Offset, err = strconv.Atoi(offset); err != nil {
+			return nil, err
+		}
+	}
+
+	return opt, nil
+}
+
+// wantsAllPages reports whether a list call should follow the pagination links. This is the case when the caller
+// did not ask for a specific page.
+func wantsAllPages(opt *ListOptions) bool {
+	return opt == nil || (opt.Limit == 0 && opt.Offset == 0)
+}
+
+// ListAll retrieves every page of a paginated list, starting at opt, by following the rel="next" links returned by
+// the API. The returned Response is the one of the last page requested.
+//
+//	users, _, err := lookergo.ListAll(ctx, &lookergo.ListOptions{Limit: 100}, client.Users.List)
+//	members, _, err := lookergo.ListAll(ctx, nil, func(ctx context.Context, opt *lookergo.ListOptions) ([]lookergo.User, *lookergo.Response, error) {
+//		return client.Groups.ListMemberUsers(ctx, groupId, opt)
+//	})
+func ListAll[T any](ctx context.Context, opt *ListOptions, list func(context.Context, *ListOptions) ([]T, *Response, error)) ([]T, *Response, error) {
+	var all []T
+	for {
+		page, resp, err := list(ctx, opt)
+		if err != nil {
+			return nil, resp, err
+		}
+		all = append(all, page...)
+
+		if resp == nil || resp.Links.IsLastPage() {
+			return all, resp, nil
+		}
+
+		next, err := resp.Links.NextPageOptions()
+		if err != nil {
+			return nil, resp, err
+		}
+		if opt != nil && *next == *opt {
+			// Guard against an API returning a next link pointing to the current page.
+			return all, resp, nil
+		}
+		opt = next
+	}
+}
+
+// doListPages requests the pages of path from opt on, following the rel="next" links until the last page, and appends
+// all results to svc. Only the limit and offset of the links are used: the next pages are requested from path on the
+// BaseURL of the client, never from the host of the links, which could receive the token of the client.
+func doListPages[T any](ctx context.Context, client *Client, path string, opt *ListOptions, svc *[]T) ([]T, *Response, error) {
+	seen := map[ListOptions]bool{}
+	for {
+		pagePath, err := addOptions(path, opt)
+		if err != nil {
+			return nil, nil, err
+		}
+		req, err := client.NewRequest(ctx, http.MethodGet, pagePath, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		seen[*opt] = true
+
+		page := new([]T)
+		resp, err := client.Do(ctx, req, page)
+		if err != nil {
+			return nil, resp, err
+		}
+		if *svc == nil {
+			*svc = *page
+		} else {
+			*svc = append(*svc, *page...)
+		}
+
+		next, err := resp.Links.NextPageOptions()
+		if err != nil {
+			return nil, resp, err
+		}
+		if next == nil || seen[*next] {
+			return *svc, resp, nil
+		}
+		opt = next
+	}
+}
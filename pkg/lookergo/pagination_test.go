@@ -0,0 +1,134 @@
+package lookergo
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestParseLinks(t *testing.T) {
+	header := `<https://x.cloud.looker.com:19999/api/4.0/users?limit=5&offset=0>; rel="first",` +
+		`<https://x.cloud.looker.com:19999/api/4.0/users?limit=5&offset=105>; rel="last",` +
+		`<https://x.cloud.looker.com:19999/api/4.0/users?limit=5&offset=5>; rel="next"`
+
+	links := parseLinks(header)
+
+	expected := Links{
+		First: "https://x.cloud.looker.com:19999/api/4.0/users?limit=5&offset=0",
+		Next:  "https://x.cloud.looker.com:19999/api/4.0/users?limit=5&offset=5",
+		Last:  "https://x.cloud.looker.com:19999/api/4.0/users?limit=5&offset=105",
+	}
+	if !reflect.DeepEqual(links, expected) {
+		t.Error(errGotWant("parseLinks", links, expected))
+	}
+
+	opt, err := links.NextPageOptions()
+	if err != nil {
+		t.Fatalf("NextPageOptions(): %v", err)
+	}
+	if expected := (&ListOptions{Limit: 5, Offset: 5}); !reflect.DeepEqual(opt, expected) {
+		t.Error(errGotWant("NextPageOptions", opt, expected))
+	}
+}
+
+// handlePages serves pages of two users from a total of five, with the same headers as the Looker API: the Link header
+// is only sent when a limit is requested, and its links point to the legacy API port of the instance.
+func handlePages(t *testing.T, path string) {
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+
+		pages := map[string]string{
+			"0": `[{"id":"1"},{"id":"2"}]`,
+			"2": `[{"id":"3"},{"id":"4"}]`,
+			"4": `[{"id":"5"}]`,
+		}
+		offset := r.URL.Query().Get("offset")
+		if offset == "" {
+			offset = "0"
+		}
+
+		link := func(offset int) string {
+			return fmt.Sprintf("https://x.cloud.looker.com:19999/api%s?limit=2&offset=%d", path, offset)
+		}
+		links := fmt.Sprintf(`<%s>; rel="first",<%s>; rel="last"`, link(0), link(4))
+		switch offset {
+		case "0":
+			links += fmt.Sprintf(`,<%s>; rel="next"`, link(2))
+		case "2":
+			links += fmt.Sprintf(`,<%s>; rel="prev",<%s>; rel="next"`, link(0), link(4))
+		case "4":
+			links += fmt.Sprintf(`,<%s>; rel="prev"`, link(2))
+		}
+
+		w.Header().Set("X-Total-Count", "5")
+		if r.URL.Query().Get("limit") != "" {
+			w.Header().Set("Link", links)
+		}
+		fmt.Fprint(w, pages[offset])
+	})
+}
+
+func TestDoList_FollowsNextLinks(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handlePages(t, "/4.0/groups/1/users")
+
+	// The next pages are requested from the client's server, not from the host of the links
+	users, resp, err := client.Groups.ListMemberUsers(ctx, 1, nil)
+	if err != nil {
+		t.Fatalf("Groups.ListMemberUsers returned error: %v", err)
+	}
+
+	expected := []User{{Id: "1"}, {Id: "2"}, {Id: "3"}, {Id: "4"}, {Id: "5"}}
+	if !reflect.DeepEqual(users, expected) {
+		t.Error(errGotWant("Groups.ListMemberUsers", users, expected))
+	}
+	if resp.TotalCount != 5 {
+		t.Errorf("Response.TotalCount = %v, expected %v", resp.TotalCount, 5)
+	}
+	if !resp.Links.IsLastPage() {
+		t.Errorf("Response.Links.Next = %v, expected last page", resp.Links.Next)
+	}
+}
+
+func TestDoList_SinglePageWithOptions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handlePages(t, "/4.0/users")
+
+	users, resp, err := client.Users.List(ctx, &ListOptions{Limit: 2, Offset: 2})
+	if err != nil {
+		t.Fatalf("Users.List returned error: %v", err)
+	}
+
+	expected := []User{{Id: "3"}, {Id: "4"}}
+	if !reflect.DeepEqual(users, expected) {
+		t.Error(errGotWant("Users.List", users, expected))
+	}
+	if resp.Links.Next == "" || resp.Links.Prev == "" {
+		t.Errorf("Response.Links = %v, expected prev and next links", resp.Links)
+	}
+}
+
+func TestListAll(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handlePages(t, "/4.0/users")
+
+	users, resp, err := ListAll(ctx, &ListOptions{Limit: 2}, client.Users.List)
+	if err != nil {
+		t.Fatalf("ListAll returned error: %v", err)
+	}
+
+	expected := []User{{Id: "1"}, {Id: "2"}, {Id: "3"}, {Id: "4"}, {Id: "5"}}
+	if !reflect.DeepEqual(users, expected) {
+		t.Error(errGotWant("ListAll", users, expected))
+	}
+	if resp.TotalCount != 5 {
+		t.Errorf("Response.TotalCount = %v, expected %v", resp.TotalCount, 5)
+	}
+}
@@ -0,0 +1,41 @@
+package lookergo
+
+import (
+	"context"
+	"net/url"
+)
+
+// ProjectFile is the metadata of a file of a project. The API does not expose the content of files, nor endpoints to
+// write or commit them.
+type ProjectFile struct {
+	Id        string          `json:"id,omitempty"`         // An opaque token uniquely identifying a file within a project
+	Path      string          `json:"path,omitempty"`       // Path, file name, and extension of the file relative to the project root directory
+	Title     string          `json:"title,omitempty"`      // Display name
+	Type      string          `json:"type,omitempty"`       // File type: model, view, etc
+	Extension string          `json:"extension,omitempty"`  // The extension of the file: .view.lkml, .model.lkml, etc
+	MimeType  string          `json:"mime_type,omitempty"`  // File mime type
+	Editable  bool            `json:"editable,omitempty"`   // State of editability for the file
+	GitStatus *GitStatus      `json:"git_status,omitempty"` // Git status of the file in the workspace of the session
+	Can       map[string]bool `json:"can,omitempty"`        // Operations the current user is able to perform on this object
+}
+
+// GitStatus is the git status of a project file.
+type GitStatus struct {
+	Action     string `json:"action,omitempty"`     // Git action: add, delete, etc
+	Conflict   bool   `json:"conflict,omitempty"`   // When true, changes to the local file conflict with the remote repository
+	Revertable bool   `json:"revertable,omitempty"` // When true, the file can be reverted to an earlier state
+	Text       string `json:"text,omitempty"`       // Git description of the action
+}
+
+// ListFiles lists the files of the project in the current workspace of the session.
+func (s *ProjectsResourceOp) ListFiles(ctx context.Context, projectName string) ([]ProjectFile, *Response, error) {
+	return doList(ctx, s.client, projectsBasePath, nil, new([]ProjectFile), projectName, "files")
+}
+
+// GetFile returns the metadata of a file of the project, by ID (its path relative to the project root).
+func (s *ProjectsResourceOp) GetFile(ctx context.Context, projectName string, fileId string) (*ProjectFile, *Response, error) {
+	qs := url.Values{}
+	qs.Add("file_id", fileId)
+
+	return doGet(ctx, s.client, projectsBasePath, new(ProjectFile), projectName, "files", "file?"+qs.Encode())
+}
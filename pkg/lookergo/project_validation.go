@@ -0,0 +1,66 @@
+package lookergo
+
+import (
+	"context"
+	"net/http"
+)
+
+// Severities of a ProjectError.
+const (
+	ProjectErrorSeverity_FATAL   = "fatal"
+	ProjectErrorSeverity_ERROR   = "error"
+	ProjectErrorSeverity_WARNING = "warning"
+	ProjectErrorSeverity_INFO    = "info"
+	ProjectErrorSeverity_SUCCESS = "success"
+)
+
+// ProjectValidation is the result of the LookML validation of a project.
+type ProjectValidation struct {
+	Errors             []ProjectError       `json:"errors,omitempty"`               // Validation errors
+	ProjectDigest      string               `json:"project_digest,omitempty"`       // A hash value computed from the project's current state
+	ModelsNotValidated []ModelsNotValidated `json:"models_not_validated,omitempty"` // Models that could not be validated, e.g. because of a missing connection
+	ComputationTime    float64              `json:"computation_time,omitempty"`     // Duration of project validation in seconds
+	Stale              bool                 `json:"stale,omitempty"`                // Whether the cached results are out of date. Only set by ValidationResults.
+}
+
+// ProjectError is an issue found by the LookML validation.
+type ProjectError struct {
+	Code             string `json:"code,omitempty"`              // A stable token that uniquely identifies this class of error, ignoring parameter values
+	Severity         string `json:"severity,omitempty"`          // Severity: fatal, error, warning, info, success
+	Kind             string `json:"kind,omitempty"`              // Error classification
+	Message          string `json:"message,omitempty"`           // Error message
+	FieldName        string `json:"field_name,omitempty"`        // The field associated with this error
+	FilePath         string `json:"file_path,omitempty"`         // Name of the file containing this error
+	LineNumber       int64  `json:"line_number,omitempty"`       // Line number in the file of this error
+	ModelId          string `json:"model_id,omitempty"`          // The model associated with this error
+	Explore          string `json:"explore,omitempty"`           // The explore associated with this error
+	HelpUrl          string `json:"help_url,omitempty"`          // A link to Looker documentation about this error
+	SanitizedMessage string `json:"sanitized_message,omitempty"` // Error message with the parameter values removed
+}
+
+// ModelsNotValidated is a model left out of the LookML validation.
+type ModelsNotValidated struct {
+	Name          string `json:"name,omitempty"`            // Model name
+	ProjectFileId string `json:"project_file_id,omitempty"` // Project file
+}
+
+// IsError returns whether the issue is an error (or a fatal error).
+func (e ProjectError) IsError() bool {
+	return e.Severity == ProjectErrorSeverity_ERROR || e.Severity == ProjectErrorSeverity_FATAL
+}
+
+// Validate runs the LookML validation of the project in the current workspace of the session. Requires the dev
+// workspace.
+func (s *ProjectsResourceOp) Validate(ctx context.Context, projectName string) (*ProjectValidation, *Response, error) {
+	return doEmptyPost(ctx, s.client, projectsBasePath, new(ProjectValidation), projectName, "validate")
+}
+
+// ValidationResults returns the cached results of the last LookML validation of the project, or nil if there are
+// none.
+func (s *ProjectsResourceOp) ValidationResults(ctx context.Context, projectName string) (*ProjectValidation, *Response, error) {
+	validation, resp, err := doGet(ctx, s.client, projectsBasePath, new(ProjectValidation), projectName, "validate")
+	if err == nil && resp.StatusCode == http.StatusNoContent {
+		return nil, resp, nil
+	}
+	return validation, resp, err
+}
@@ -9,6 +9,7 @@ import (
 	"log"
 	"net/http"
 	"net/url"
+	"strings"
 
 	"golang.org/x/crypto/ssh"
 )
@@ -39,6 +40,12 @@ type ProjectsResource interface {
 	DeployToProduction(ctx context.Context, projectName string) (*string, *Response, error)
 	GitDeployKeyGet(ctx context.Context, projectName string) (*string, *Response, error)
 	GitDeployKeyCreate(ctx context.Context, projectName string) (*string, *Response, error)
+	SetDeploySecret(ctx context.Context, projectName string, secret string) (*Response, error)
+	DeployWebhookURL(projectName string) string
+	Validate(ctx context.Context, projectName string) (*ProjectValidation, *Response, error)
+	ValidationResults(ctx context.Context, projectName string) (*ProjectValidation, *Response, error)
+	ListFiles(ctx context.Context, projectName string) ([]ProjectFile, *Response, error)
+	GetFile(ctx context.Context, projectName string, fileId string) (*ProjectFile, *Response, error)
 	// GitDeployKeyDelete(ctx context.Context, projectName string) (*Response, error) // Doesn't exist
 }
 
@@ -139,8 +146,8 @@ type GitBranch struct {
 
 // GitBranchRef -
 type GitBranchRef struct {
-	Name string `json:"name"`
-	Ref  string `json:"ref"`
+	Name string `json:"name,omitempty"`
+	Ref  string `json:"ref,omitempty"`
 }
 
 func (s *ProjectsResourceOp) Get(ctx context.Context, projectName string) (*Project, *Response, error) {
@@ -200,20 +207,38 @@ func (s *ProjectsResourceOp) GitBranchActiveGet(ctx context.Context, projectName
 	return doGet(ctx, s.client, projectsBasePath, new(GitBranch), projectName, "git_branch")
 }
 
+// GitBranchCheckout creates the branch gbr.Name from gbr.Ref (HEAD of the current branch if empty) and checks it out.
+// Requires the dev workspace.
 func (s *ProjectsResourceOp) GitBranchCheckout(ctx context.Context, projectName string, gbr *GitBranchRef) (*GitBranch, *Response, error) {
-	return doCreate(ctx, s.client, projectsBasePath, new(GitBranchRef), new(GitBranch), projectName, "git_branch")
+	return doCreate(ctx, s.client, projectsBasePath, gbr, new(GitBranch), projectName, "git_branch")
 }
 
+// GitBranchUpdate checks out the branch gbr.Name and resets it to gbr.Ref (git reset --hard). The reset is force
+// pushed to the remote. Requires the dev workspace.
 func (s *ProjectsResourceOp) GitBranchUpdate(ctx context.Context, projectName string, gbr *GitBranchRef) (*GitBranch, *Response, error) {
-	return doUpdate(ctx, s.client, projectsBasePath, projectName, gbr, new(GitBranch), projectName, "git_branch")
+	path := fmt.Sprintf("%s/%s/git_branch", projectsBasePath, projectName)
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path, gbr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	branch := new(GitBranch)
+	resp, err := s.client.Do(ctx, req, branch)
+	if err != nil {
+		return nil, resp, err
+	}
+	return branch, resp, err
 }
 
+// GitBranchListByName returns the branch of the project with the given name.
 func (s *ProjectsResourceOp) GitBranchListByName(ctx context.Context, projectName string, branchName string) (*GitBranch, *Response, error) {
-	panic("Not implemented")
+	return doGet(ctx, s.client, projectsBasePath, new(GitBranch), projectName, "git_branch", url.PathEscape(branchName))
 }
 
+// GitBranchDelete deletes a branch of the project. The current branch can't be deleted.
 func (s *ProjectsResourceOp) GitBranchDelete(ctx context.Context, projectName string, branchName string) (*Response, error) {
-	return doDelete(ctx, s.client, projectsBasePath, projectName, "git_branch", branchName)
+	return doDelete(ctx, s.client, projectsBasePath, projectName, "git_branch", url.PathEscape(branchName))
 }
 
 func (s *ProjectsResourceOp) GitBranchDeployToProduction(ctx context.Context, projectName string, branch string) (*string, *Response, error) {
@@ -349,6 +374,33 @@ func (s *ProjectsResourceOp) AllowWarnings(ctx context.Context, projectName stri
 	return resp, err
 }
 
+// SetDeploySecret sets the secret the deploy webhook of a project requires in the X-Looker-Deploy-Secret header. An
+// empty secret unsets it, leaving the webhook unauthenticated. Dev mode required.
+func (s *ProjectsResourceOp) SetDeploySecret(ctx context.Context, projectName string, secret string) (*Response, error) {
+	proj := &Project{DeploySecret: secret}
+	if secret == "" {
+		proj.UnsetDeploySecret = Bool(true)
+	}
+	_, resp, err := s.Update(ctx, projectName, proj)
+	return resp, err
+}
+
+// legacyApiPort is the port of the API of instances that don't serve it under /api on the web port.
+const legacyApiPort = "19999"
+
+// DeployWebhookURL returns the URL of the webhook deploying the production branch of a project. Append /branch/<name>
+// or /ref/<ref> to it to deploy another branch or ref. The webhook is served by the instance rather than the API, so the
+// URL is the base URL of the client without its api/ path, nor the legacy API port 19999.
+func (s *ProjectsResourceOp) DeployWebhookURL(projectName string) string {
+	u := *s.client.BaseURL
+	if u.Port() == legacyApiPort {
+		u.Host = strings.TrimSuffix(u.Host, ":"+legacyApiPort)
+	}
+	u.Path = strings.TrimSuffix(strings.TrimSuffix(u.Path, "/"), "/api") + "/webhooks/projects/" + projectName + "/deploy"
+	u.RawPath = ""
+	return u.String()
+}
+
 // func (s *ProjectsResourceOp) GitDeployKeyDelete(ctx context.Context, projectName string) (*Response, error) {
 //
 // }
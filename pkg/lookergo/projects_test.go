@@ -2,8 +2,10 @@ package lookergo
 
 import (
 	"fmt"
+	"io"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -69,3 +71,178 @@ func TestProjectsResourceOp_Get(t *testing.T) {
 		t.Error(errGotWant("Projects.Get", result, expected))
 	}
 }
+
+func TestProjectsResourceOp_Validate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	cached := false
+	mux.HandleFunc("/4.0/projects/hub/validate", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet && !cached {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		if r.Method == http.MethodPost {
+			cached = true
+		}
+		fmt.Fprint(w, `{"project_digest":"abc","errors":[{"severity":"error","message":"Unknown view orders","file_path":"hub/sales.model.lkml","line_number":12}]}`)
+	})
+
+	results, _, err := client.Projects.ValidationResults(ctx, "hub")
+	if err != nil || results != nil {
+		t.Fatalf("Projects.ValidationResults = %v, %v, expected no results", results, err)
+	}
+
+	validation, _, err := client.Projects.Validate(ctx, "hub")
+	if err != nil {
+		t.Fatalf("Projects.Validate returned error: %v", err)
+	}
+
+	expected := &ProjectValidation{
+		ProjectDigest: "abc",
+		Errors:        []ProjectError{{Severity: "error", Message: "Unknown view orders", FilePath: "hub/sales.model.lkml", LineNumber: 12}},
+	}
+	if !reflect.DeepEqual(validation, expected) {
+		t.Error(errGotWant("Projects.Validate", validation, expected))
+	}
+	if !validation.Errors[0].IsError() {
+		t.Errorf("expected an error")
+	}
+
+	results, _, err = client.Projects.ValidationResults(ctx, "hub")
+	if err != nil || !reflect.DeepEqual(results, expected) {
+		t.Error(errGotWant("Projects.ValidationResults", results, expected))
+	}
+}
+
+func TestProjectsResourceOp_GitBranchUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/4.0/projects/hub/git_branch", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		fmt.Fprint(w, `{"name":"dev-api-user","ref":"0a1b2c"}`)
+	})
+
+	branch, _, err := client.Projects.GitBranchUpdate(ctx, "hub", &GitBranchRef{Name: "dev-api-user", Ref: "v1.2.0"})
+	if err != nil {
+		t.Fatalf("Projects.GitBranchUpdate returned error: %v", err)
+	}
+
+	expected := &GitBranch{Name: "dev-api-user", Ref: "0a1b2c"}
+	if !reflect.DeepEqual(branch, expected) {
+		t.Error(errGotWant("Projects.GitBranchUpdate", branch, expected))
+	}
+}
+
+func TestProjectsResourceOp_GetFile(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/4.0/projects/hub/files/file", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testFormValues(t, r, values{"file_id": "views/orders.view.lkml"})
+		fmt.Fprint(w, `{"id":"views/orders.view.lkml","path":"views/orders.view.lkml","type":"view","editable":true,"git_status":{"action":"modify","text":"Modified"}}`)
+	})
+
+	file, _, err := client.Projects.GetFile(ctx, "hub", "views/orders.view.lkml")
+	if err != nil {
+		t.Fatalf("Projects.GetFile returned error: %v", err)
+	}
+
+	expected := &ProjectFile{Id: "views/orders.view.lkml", Path: "views/orders.view.lkml", Type: "view", Editable: true, GitStatus: &GitStatus{Action: "modify", Text: "Modified"}}
+	if !reflect.DeepEqual(file, expected) {
+		t.Error(errGotWant("Projects.GetFile", file, expected))
+	}
+}
+
+func TestProjectsResourceOp_GitBranch(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var requests []string
+	mux.HandleFunc("/4.0/projects/hub/git_branch", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		raw, _ := io.ReadAll(r.Body)
+		requests = append(requests, r.Method+" "+strings.TrimSpace(string(raw)))
+		fmt.Fprint(w, `{"name":"release/1.2","ref":"0a1b2c"}`)
+	})
+	mux.HandleFunc("/4.0/projects/hub/git_branch/", func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.EscapedPath())
+		if r.Method == http.MethodGet {
+			fmt.Fprint(w, `{"name":"release/1.2","ref":"0a1b2c","ahead_count":2}`)
+		}
+	})
+
+	if _, _, err := client.Projects.GitBranchCheckout(ctx, "hub", &GitBranchRef{Name: "release/1.2", Ref: "v1.2.0"}); err != nil {
+		t.Fatalf("Projects.GitBranchCheckout returned error: %v", err)
+	}
+	branch, _, err := client.Projects.GitBranchListByName(ctx, "hub", "release/1.2")
+	if err != nil {
+		t.Fatalf("Projects.GitBranchListByName returned error: %v", err)
+	}
+	if _, err = client.Projects.GitBranchDelete(ctx, "hub", "release/1.2"); err != nil {
+		t.Fatalf("Projects.GitBranchDelete returned error: %v", err)
+	}
+
+	expected := &GitBranch{Name: "release/1.2", Ref: "0a1b2c", AheadCount: 2}
+	if !reflect.DeepEqual(branch, expected) {
+		t.Error(errGotWant("Projects.GitBranchListByName", branch, expected))
+	}
+	expectedRequests := []string{
+		`POST {"name":"release/1.2","ref":"v1.2.0"}`,
+		"GET /4.0/projects/hub/git_branch/release%2F1.2",
+		"DELETE /4.0/projects/hub/git_branch/release%2F1.2",
+	}
+	if !reflect.DeepEqual(requests, expectedRequests) {
+		t.Error(errGotWant("requests", requests, expectedRequests))
+	}
+}
+
+func TestProjectsResourceOp_DeploySecret(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var body string
+	mux.HandleFunc("/4.0/projects/hub", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPatch)
+		raw, _ := io.ReadAll(r.Body)
+		body = strings.TrimSpace(string(raw))
+		fmt.Fprint(w, `{"id":"hub","name":"hub"}`)
+	})
+
+	for _, tc := range []struct {
+		secret   string
+		expected string
+	}{
+		{"s3cr3t", `{"deploy_secret":"s3cr3t"}`},
+		{"", `{"unset_deploy_secret":true}`},
+	} {
+		if _, err := client.Projects.SetDeploySecret(ctx, "hub", tc.secret); err != nil {
+			t.Fatalf("Projects.SetDeploySecret returned error: %v", err)
+		}
+		if body != tc.expected {
+			t.Errorf("Projects.SetDeploySecret(%q) request body = %s, expected %s", tc.secret, body, tc.expected)
+		}
+	}
+}
+
+func TestProjectsResourceOp_DeployWebhookURL(t *testing.T) {
+	for _, tc := range []struct {
+		baseURL  string
+		expected string
+	}{
+		{"https://example.looker.com/api/", "https://example.looker.com/webhooks/projects/hub/deploy"},
+		{"https://example.looker.com:19999/api", "https://example.looker.com/webhooks/projects/hub/deploy"},
+		{"https://looker.internal:9999/api/", "https://looker.internal:9999/webhooks/projects/hub/deploy"},
+		{"https://example.com/looker/", "https://example.com/looker/webhooks/projects/hub/deploy"},
+	} {
+		c := NewClient(nil)
+		if err := c.SetBaseURL(tc.baseURL); err != nil {
+			t.Fatal(err)
+		}
+		if got := c.Projects.DeployWebhookURL("hub"); got != tc.expected {
+			t.Errorf("Projects.DeployWebhookURL with base URL %s = %s, expected %s", tc.baseURL, got, tc.expected)
+		}
+	}
+}
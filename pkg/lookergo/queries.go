@@ -0,0 +1,49 @@
+package lookergo
+
+import (
+	"context"
+)
+
+const QueriesBasePath = "4.0/queries"
+
+// QueriesResource manages queries. Queries are immutable: changing a query means creating a new one.
+type QueriesResource interface {
+	Get(context.Context, string) (*Query, *Response, error)
+	Create(context.Context, *Query) (*Query, *Response, error)
+}
+
+type QueriesResourceOp struct {
+	client *Client
+}
+
+var _ QueriesResource = &QueriesResourceOp{}
+
+type Query struct {
+	Id               string            `json:"id,omitempty"`                // Unique Id
+	Model            string            `json:"model"`                       // Model
+	View             string            `json:"view"`                        // Explore Name
+	Fields           []string          `json:"fields,omitempty"`            // Fields
+	Pivots           []string          `json:"pivots,omitempty"`            // Pivots
+	FillFields       []string          `json:"fill_fields,omitempty"`       // Fill Fields
+	Filters          map[string]string `json:"filters,omitempty"`           // Filters will contain data pertaining to complex filters that do not contain "or" conditions.
+	FilterExpression *string           `json:"filter_expression,omitempty"` // Filter Expression
+	Sorts            []string          `json:"sorts,omitempty"`             // Sorting for the query results. Use the format `["view.field", ...]` to sort on fields in ascending order. Use the format `["view.field desc", ...]` to sort on fields in descending order.
+	Limit            *string           `json:"limit,omitempty"`             // Row limit. To download unlimited results, set the limit to -1 (negative one).
+	ColumnLimit      *string           `json:"column_limit,omitempty"`      // Column Limit
+	Total            *bool             `json:"total,omitempty"`             // Total
+	RowTotal         *string           `json:"row_total,omitempty"`         // Raw Total
+	DynamicFields    *string           `json:"dynamic_fields,omitempty"`    // Dynamic Fields
+	QueryTimezone    *string           `json:"query_timezone,omitempty"`    // Query Timezone
+	ClientId         string            `json:"client_id,omitempty"`         // Client Id: used to generate shortened explore URLs.
+	Slug             string            `json:"slug,omitempty"`              // Slug
+	ShareUrl         string            `json:"share_url,omitempty"`         // Share Url
+	Url              string            `json:"url,omitempty"`               // Expanded Url
+}
+
+func (s *QueriesResourceOp) Get(ctx context.Context, QueryId string) (*Query, *Response, error) {
+	return doGetById(ctx, s.client, QueriesBasePath, QueryId, new(Query))
+}
+
+func (s *QueriesResourceOp) Create(ctx context.Context, requestQuery *Query) (*Query, *Response, error) {
+	return doCreate(ctx, s.client, QueriesBasePath, requestQuery, new(Query))
+}
@@ -0,0 +1,129 @@
+package lookergo
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// DefaultRequestsPerSecond is the request rate new clients are limited to.
+const DefaultRequestsPerSecond = 10
+
+// rateLimiter is a token bucket limiting the requests a single Client sends. Its rate adapts to the API: it is
+// halved every time the API answers 429 Too Many Requests and slowly recovers with every successful request.
+type rateLimiter struct {
+	mu sync.Mutex
+
+	// Configured requests per second. 0 disables the limiter.
+	limit float64
+	// Current requests per second, at most limit.
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newRateLimiter(requestsPerSecond float64) *rateLimiter {
+	l := &rateLimiter{}
+	l.setLimit(requestsPerSecond)
+	return l
+}
+
+func (l *rateLimiter) setLimit(requestsPerSecond float64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.limit = requestsPerSecond
+	l.rate = requestsPerSecond
+	l.burst = math.Max(1, math.Ceil(requestsPerSecond))
+	l.tokens = l.burst
+	l.last = time.Now()
+}
+
+// reserve takes a token from the bucket and returns how long the caller has to wait before using it.
+func (l *rateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.limit <= 0 {
+		return 0
+	}
+
+	now := time.Now()
+	l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// Wait blocks until a request may be sent, or until ctx is done.
+func (l *rateLimiter) Wait(ctx context.Context) error {
+	wait := l.reserve()
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// throttle halves the current rate after the API answered 429 Too Many Requests.
+func (l *rateLimiter) throttle() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.limit <= 0 {
+		return
+	}
+	l.rate = math.Max(l.rate/2, math.Min(l.limit, 0.1))
+	l.tokens = math.Min(l.tokens, 0)
+}
+
+// recover raises the current rate back towards the configured limit after a successful request.
+func (l *rateLimiter) recover() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.limit <= 0 || l.rate >= l.limit {
+		return
+	}
+	l.rate = math.Min(l.limit, l.rate+l.limit/10)
+}
+
+// configuredLimit returns the requests per second the limiter was configured with.
+func (l *rateLimiter) configuredLimit() float64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.limit
+}
+
+// currentRate returns the requests per second the limiter currently allows.
+func (l *rateLimiter) currentRate() float64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.rate
+}
+
+// SetRateLimit is a client option for limiting the number of requests per second the client sends.
+// A value of 0 disables the limiter.
+func (c *Client) SetRateLimit(requestsPerSecond float64) error {
+	if requestsPerSecond < 0 {
+		return NewArgError("requestsPerSecond", "cannot be negative")
+	}
+
+	c.limiter.setLimit(requestsPerSecond)
+	return nil
+}
@@ -0,0 +1,111 @@
+package lookergo
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestRateLimiter_Throttles(t *testing.T) {
+	l := newRateLimiter(10)
+
+	start := time.Now()
+	for i := 0; i < 12; i++ {
+		if err := l.Wait(ctx); err != nil {
+			t.Fatalf("Wait(): %v", err)
+		}
+	}
+
+	// A burst of 10, then 2 more requests at 10 requests per second.
+	if elapsed := time.Since(start); elapsed < 150*time.Millisecond {
+		t.Errorf("12 requests took %v, expected at least %v", elapsed, 150*time.Millisecond)
+	}
+}
+
+func TestRateLimiter_Disabled(t *testing.T) {
+	l := newRateLimiter(0)
+
+	for i := 0; i < 1000; i++ {
+		if wait := l.reserve(); wait != 0 {
+			t.Fatalf("reserve() = %v, expected no wait", wait)
+		}
+	}
+}
+
+func TestRateLimiter_AdaptsToTooManyRequests(t *testing.T) {
+	l := newRateLimiter(10)
+
+	l.throttle()
+	if rate := l.currentRate(); rate != 5 {
+		t.Errorf("rate after throttle = %v, expected %v", rate, 5)
+	}
+
+	for i := 0; i < 10; i++ {
+		l.recover()
+	}
+	if rate := l.currentRate(); rate != 10 {
+		t.Errorf("rate after recovery = %v, expected %v", rate, 10)
+	}
+}
+
+func TestClient_RateLimitersAreIndependent(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/4.0/session", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"workspace_id":"production"}`)
+	})
+
+	other := NewClient(nil)
+	other.BaseURL, _ = url.Parse(server.URL)
+
+	for _, c := range []*Client{client, other} {
+		if err := c.SetRateLimit(1); err != nil {
+			t.Fatalf("SetRateLimit(): %v", err)
+		}
+	}
+
+	start := time.Now()
+	for _, c := range []*Client{client, other} {
+		if _, _, err := c.Sessions.Get(ctx); err != nil {
+			t.Fatalf("Sessions.Get returned error: %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed >= 500*time.Millisecond {
+		t.Errorf("one request on each client took %v, expected the clients not to throttle each other", elapsed)
+	}
+
+	// The second request on the same client has to wait for a new token.
+	start = time.Now()
+	if _, _, err := client.Sessions.Get(ctx); err != nil {
+		t.Fatalf("Sessions.Get returned error: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 500*time.Millisecond {
+		t.Errorf("second request on the same client took %v, expected it to be throttled", elapsed)
+	}
+}
+
+func TestClient_RateLimiterThrottlesOnTooManyRequests(t *testing.T) {
+	setup()
+	defer teardown()
+	setRetryPolicy(t, RetryPolicy{MaxAttempts: 2, MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond})
+
+	attempts := 0
+	mux.HandleFunc("/4.0/session", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprint(w, `{"workspace_id":"production"}`)
+	})
+
+	if _, _, err := client.Sessions.Get(ctx); err != nil {
+		t.Fatalf("Sessions.Get returned error: %v", err)
+	}
+	if rate := client.limiter.currentRate(); rate >= DefaultRequestsPerSecond {
+		t.Errorf("rate after 429 = %v, expected less than %v", rate, DefaultRequestsPerSecond)
+	}
+}
@@ -0,0 +1,232 @@
+package lookergo
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// RetryPolicy configures how Client.Do retries failed requests.
+//
+// A request is retried when the API answers with 429 Too Many Requests or a 5xx server error, or when the
+// connection fails with a transient network error. Only safe methods (GET, HEAD, OPTIONS) are retried unless
+// RetryIdempotentWrites is set, which also retries PATCH, PUT and DELETE. POST requests are never retried.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts for a single request, including the first one.
+	// A value of 1 or less disables retries.
+	MaxAttempts int
+
+	// MinBackoff is the wait before the first retry. It doubles on every following retry.
+	MinBackoff time.Duration
+
+	// MaxBackoff caps the exponential backoff, and the wait asked for by a Retry-After header.
+	MaxBackoff time.Duration
+
+	// RetryIdempotentWrites opts in to retrying PATCH, PUT and DELETE requests.
+	RetryIdempotentWrites bool
+}
+
+// DefaultRetryPolicy returns the retry policy used by new clients.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 3,
+		MinBackoff:  1 * time.Second,
+		MaxBackoff:  30 * time.Second,
+	}
+}
+
+// SetRetryPolicy is a client option for setting the retry policy.
+func (c *Client) SetRetryPolicy(policy RetryPolicy) error {
+	if policy.MinBackoff < 0 || policy.MaxBackoff < 0 {
+		return NewArgError("backoff", "cannot be negative")
+	}
+	if policy.MaxBackoff < policy.MinBackoff {
+		return NewArgError("MaxBackoff", "cannot be less than MinBackoff")
+	}
+
+	c.retryPolicy = policy
+	return nil
+}
+
+// RetryPolicy returns the retry policy of the client.
+func (c *Client) RetryPolicy() RetryPolicy {
+	return c.retryPolicy
+}
+
+// retryableMethod reports whether requests with the given method may be sent more than once.
+func (p RetryPolicy) retryableMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	case http.MethodPatch, http.MethodPut, http.MethodDelete:
+		return p.RetryIdempotentWrites
+	default:
+		return false
+	}
+}
+
+// shouldRetry decides if a request should be attempted again after the given result.
+func (p RetryPolicy) shouldRetry(ctx context.Context, req *http.Request, resp *http.Response, err error, attempt int) bool {
+	if attempt >= p.MaxAttempts || ctx.Err() != nil || !p.retryableMethod(req.Method) {
+		return false
+	}
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		// The body cannot be sent a second time.
+		return false
+	}
+
+	if err != nil {
+		return isTransientErr(err)
+	}
+
+	switch code := resp.StatusCode; {
+	case code == http.StatusTooManyRequests:
+		return true
+	case code == http.StatusNotImplemented || code == http.StatusHTTPVersionNotSupported:
+		return false
+	case code >= 500 && code <= 599:
+		return true
+	}
+
+	return false
+}
+
+// backoff returns the wait before the next attempt. Retry-After from the response takes precedence, otherwise an
+// exponential backoff with jitter is used. Both are capped by MaxBackoff, so a bogus Retry-After can't stall a run.
+func (p RetryPolicy) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			if wait > p.MaxBackoff {
+				wait = p.MaxBackoff
+			}
+			return wait
+		}
+	}
+
+	wait := float64(p.MinBackoff) * math.Pow(2, float64(attempt-1))
+	if max := float64(p.MaxBackoff); wait > max {
+		wait = max
+	}
+
+	// Equal jitter: keep half of the backoff and randomize the other half.
+	half := time.Duration(wait / 2)
+	if half <= 0 {
+		return time.Duration(wait)
+	}
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// parseRetryAfter parses the Retry-After header, which is either a number of seconds or an HTTP date.
+func parseRetryAfter(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(header); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(header); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
+
+// isTransientErr reports whether a transport error is worth retrying.
+func isTransientErr(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var retrieveErr *oauth2.RetrieveError
+	if errors.As(err, &retrieveErr) {
+		// Authentication failures are not going to fix themselves.
+		return false
+	}
+
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// rewindRequest returns a copy of req with a fresh body, so that it can be sent again.
+func rewindRequest(ctx context.Context, req *http.Request) (*http.Request, error) {
+	r := req.Clone(ctx)
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		r.Body = body
+	}
+	return r, nil
+}
+
+// doWithRetry sends req, retrying according to the retry policy of the client.
+func (c *Client) doWithRetry(ctx context.Context, req *http.Request) (*http.Response, error) {
+	policy := c.retryPolicy
+
+	for attempt := 1; ; attempt++ {
+		r := req
+		if attempt > 1 {
+			var err error
+			if r, err = rewindRequest(ctx, req); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := c.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+
+		resp, err := DoRequestWithClient(ctx, c.client, r)
+		if err == nil {
+			if resp.StatusCode == http.StatusTooManyRequests {
+				c.limiter.throttle()
+			} else {
+				c.limiter.recover()
+			}
+			if c.onRequestCompleted != nil {
+				c.onRequestCompleted(r, resp)
+			}
+		}
+
+		if !policy.shouldRetry(ctx, r, resp, err, attempt) {
+			return resp, err
+		}
+
+		wait := policy.backoff(attempt, resp)
+		if resp != nil {
+			// Release the connection before waiting.
+			_, _ = io.Copy(ioutil.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
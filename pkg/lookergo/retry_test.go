@@ -0,0 +1,155 @@
+package lookergo
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func setRetryPolicy(t *testing.T, policy RetryPolicy) {
+	if err := client.SetRetryPolicy(policy); err != nil {
+		t.Fatalf("SetRetryPolicy(): %v", err)
+	}
+}
+
+func TestDo_RetriesServerErrors(t *testing.T) {
+	setup()
+	defer teardown()
+	setRetryPolicy(t, RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond})
+
+	attempts := 0
+	mux.HandleFunc("/4.0/groups/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		fmt.Fprint(w, `{"id":"1","name":"Admins"}`)
+	})
+
+	group, _, err := client.Groups.Get(ctx, 1)
+	if err != nil {
+		t.Fatalf("Groups.Get returned error: %v", err)
+	}
+	if group.Name != "Admins" {
+		t.Errorf("Group.Name = %v, expected %v", group.Name, "Admins")
+	}
+	if attempts != 3 {
+		t.Errorf("attempts = %v, expected %v", attempts, 3)
+	}
+}
+
+func TestDo_StopsAfterMaxAttempts(t *testing.T) {
+	setup()
+	defer teardown()
+	setRetryPolicy(t, RetryPolicy{MaxAttempts: 2, MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond})
+
+	attempts := 0
+	mux.HandleFunc("/4.0/groups/1", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	_, resp, err := client.Groups.Get(ctx, 1)
+	if err == nil {
+		t.Fatal("Expected error to be returned")
+	}
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("Response.StatusCode = %v, expected %v", resp.StatusCode, http.StatusServiceUnavailable)
+	}
+	if attempts != 2 {
+		t.Errorf("attempts = %v, expected %v", attempts, 2)
+	}
+}
+
+func TestDo_DoesNotRetryPost(t *testing.T) {
+	setup()
+	defer teardown()
+	setRetryPolicy(t, RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond, RetryIdempotentWrites: true})
+
+	attempts := 0
+	mux.HandleFunc("/4.0/groups", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		attempts++
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	if _, _, err := client.Groups.Create(ctx, &Group{Name: "Admins"}); err == nil {
+		t.Fatal("Expected error to be returned")
+	}
+	if attempts != 1 {
+		t.Errorf("attempts = %v, expected %v", attempts, 1)
+	}
+}
+
+func TestDo_RetriesIdempotentWritesOnlyWhenEnabled(t *testing.T) {
+	for _, enabled := range []bool{false, true} {
+		t.Run(fmt.Sprintf("RetryIdempotentWrites=%v", enabled), func(t *testing.T) {
+			setup()
+			defer teardown()
+			setRetryPolicy(t, RetryPolicy{MaxAttempts: 2, MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond, RetryIdempotentWrites: enabled})
+
+			var bodies []string
+			mux.HandleFunc("/4.0/groups/1", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, http.MethodPatch)
+				b, _ := io.ReadAll(r.Body)
+				bodies = append(bodies, string(b))
+				if len(bodies) == 1 {
+					w.WriteHeader(http.StatusTooManyRequests)
+					return
+				}
+				fmt.Fprint(w, `{"id":"1","name":"Admins"}`)
+			})
+
+			_, _, err := client.Groups.Update(ctx, 1, &Group{Name: "Admins"})
+			if enabled {
+				if err != nil {
+					t.Fatalf("Groups.Update returned error: %v", err)
+				}
+				if len(bodies) != 2 || bodies[0] != bodies[1] {
+					t.Errorf("request bodies = %q, expected the same body twice", bodies)
+				}
+			} else {
+				if err == nil {
+					t.Fatal("Expected error to be returned")
+				}
+				if len(bodies) != 1 {
+					t.Errorf("attempts = %v, expected %v", len(bodies), 1)
+				}
+			}
+		})
+	}
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 5, MinBackoff: 100 * time.Millisecond, MaxBackoff: 300 * time.Millisecond}
+
+	for attempt, max := range map[int]time.Duration{1: 100 * time.Millisecond, 2: 200 * time.Millisecond, 4: 300 * time.Millisecond} {
+		wait := policy.backoff(attempt, nil)
+		if wait < max/2 || wait > max {
+			t.Errorf("backoff(%d) = %v, expected between %v and %v", attempt, wait, max/2, max)
+		}
+	}
+
+	resp := &http.Response{Header: http.Header{}}
+	resp.Header.Set("Retry-After", "7")
+	if wait := (RetryPolicy{MaxBackoff: 30 * time.Second}).backoff(1, resp); wait != 7*time.Second {
+		t.Errorf("backoff() with Retry-After = %v, expected %v", wait, 7*time.Second)
+	}
+
+	// Retry-After beyond MaxBackoff is capped
+	for _, header := range []string{"86400", time.Now().Add(24 * time.Hour).UTC().Format(http.TimeFormat)} {
+		resp.Header.Set("Retry-After", header)
+		if wait := policy.backoff(1, resp); wait != policy.MaxBackoff {
+			t.Errorf("backoff() with Retry-After %s = %v, expected %v", header, wait, policy.MaxBackoff)
+		}
+	}
+
+	resp.Header.Set("Retry-After", time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat))
+	if wait := policy.backoff(1, resp); wait != 0 {
+		t.Errorf("backoff() with past Retry-After date = %v, expected 0", wait)
+	}
+}
@@ -31,6 +31,7 @@ type PermissionSetSlice []PermissionSet
 type RolesResource interface {
 	List(context.Context, *ListOptions) ([]Role, *Response, error)
 	ListByName(ctx context.Context, name string, opt *ListOptions) ([]Role, *Response, error)
+	Search(context.Context, *RoleSearch, *ListOptions) ([]Role, *Response, error)
 	Get(context.Context, int) (*Role, *Response, error)
 	Create(context.Context, *Role) (*Role, *Response, error)
 	Update(context.Context, int, *Role) (*Role, *Response, error)
@@ -38,6 +39,7 @@ type RolesResource interface {
 	RoleGroupsList(context.Context, int, *ListOptions) ([]Group, *Response, error)
 	RoleGroupsSet(context.Context, int, []string) ([]Group, *Response, error)
 	RoleUsersList(context.Context, int, *ListOptions) ([]User, *Response, error)
+	RoleDirectUsersList(context.Context, int, *ListOptions) ([]User, *Response, error)
 	RoleUsersSet(context.Context, int, []string) ([]User, *Response, error)
 }
 
@@ -64,6 +66,19 @@ func (s *RolesResourceOp) ListByName(ctx context.Context, name string, opt *List
 	return doListByX(ctx, s.client, path, opt, new([]Role), qs)
 }
 
+// RoleSearch filters the roles returned by Search. Name supports the wildcards % (any characters) and _ (any single
+// character).
+type RoleSearch struct {
+	Name    string `url:"name,omitempty"`
+	BuiltIn *bool  `url:"built_in,omitempty"`
+	Sorts   string `url:"sorts,omitempty"`
+}
+
+// Search roles matching all the given filters. Without limit/offset options, all pages are retrieved.
+func (s *RolesResourceOp) Search(ctx context.Context, search *RoleSearch, opt *ListOptions) ([]Role, *Response, error) {
+	return doSearch(ctx, s.client, roleBasePath, search, opt, new([]Role))
+}
+
 // Get -
 func (s *RolesResourceOp) Get(ctx context.Context, id int) (*Role, *Response, error) {
 	return doGetById(ctx, s.client, roleBasePath, id, new(Role))
@@ -89,7 +104,7 @@ func (s *RolesResourceOp) RoleGroupsList(ctx context.Context, id int, opt *ListO
 	return doList(ctx, s.client, roleBasePath, opt, new([]Group), strconv.Itoa(id), "groups")
 }
 
-// RoleGroupsSet -
+// RoleGroupsSet replaces the groups the role is assigned to. An empty groupIds removes the role from all groups.
 func (s *RolesResourceOp) RoleGroupsSet(ctx context.Context, id int, groupIds []string) ([]Group, *Response, error) {
 	return doSet(ctx, s.client, roleBasePath, groupIds, new([]Group), strconv.Itoa(id), "groups")
 }
@@ -99,7 +114,14 @@ func (s *RolesResourceOp) RoleUsersList(ctx context.Context, id int, opt *ListOp
 	return doList(ctx, s.client, roleBasePath, opt, new([]User), strconv.Itoa(id), "users")
 }
 
-// RoleUsersSet -
+// RoleDirectUsersList gets the users the role is assigned to, excluding the users that only get the role through a group.
+func (s *RolesResourceOp) RoleDirectUsersList(ctx context.Context, id int, opt *ListOptions) ([]User, *Response, error) {
+	qs := url.Values{}
+	qs.Add("direct_association_only", "true")
+	return doListByX(ctx, s.client, fmt.Sprintf("%s/%d/users", roleBasePath, id), opt, new([]User), qs)
+}
+
+// RoleUsersSet replaces the users the role is assigned to. An empty userIds removes the role from all users.
 func (s *RolesResourceOp) RoleUsersSet(ctx context.Context, id int, userIds []string) ([]User, *Response, error) {
 	return doSet(ctx, s.client, roleBasePath, userIds, new([]User), strconv.Itoa(id), "users")
 }
@@ -0,0 +1,108 @@
+package lookergo
+
+import (
+	"context"
+	"net/http"
+)
+
+const (
+	SamlConfigBasePath     = "4.0/saml_config"
+	SamlTestConfigBasePath = "4.0/saml_test_configs"
+)
+
+// SamlConfigResource is an interface for interfacing with the SAML authentication configuration endpoints of the API.
+// Ref: https://developers.looker.com/api/explorer/4.0/methods/Auth/saml_config
+type SamlConfigResource interface {
+	Get(ctx context.Context) (*SamlConfig, *Response, error)
+	Update(ctx context.Context, config *SamlConfig) (*SamlConfig, *Response, error)
+	CreateTestConfig(ctx context.Context, config *SamlConfig) (*SamlConfig, *Response, error)
+	GetTestConfig(ctx context.Context, testSlug string) (*SamlConfig, *Response, error)
+	DeleteTestConfig(ctx context.Context, testSlug string) (*Response, error)
+}
+
+type SamlConfigResourceOp struct {
+	client *Client
+}
+
+var _ SamlConfigResource = &SamlConfigResourceOp{}
+
+// SamlConfig is the SAML authentication configuration of the instance. Fields left nil are not changed by Update.
+type SamlConfig struct {
+	Enabled                    *bool                       `json:"enabled,omitempty"`                        // Enable/Disable Saml authentication for the server
+	IdpCert                    *string                     `json:"idp_cert,omitempty"`                       // Identity Provider Certificate (provided by IdP)
+	IdpUrl                     *string                     `json:"idp_url,omitempty"`                        // Identity Provider Url (provided by IdP)
+	IdpIssuer                  *string                     `json:"idp_issuer,omitempty"`                     // Identity Provider Issuer (provided by IdP)
+	IdpAudience                *string                     `json:"idp_audience,omitempty"`                   // Identity Provider Audience (set in IdP config). Optional in Looker. Set this only if you want Looker to validate the audience value returned by the IdP.
+	AllowedClockDrift          *int64                      `json:"allowed_clock_drift,omitempty"`            // Count of seconds of clock drift to allow when validating timestamps of assertions.
+	UserAttributeMapEmail      *string                     `json:"user_attribute_map_email,omitempty"`       // Name of user record attributes used to indicate email address field
+	UserAttributeMapFirstName  *string                     `json:"user_attribute_map_first_name,omitempty"`  // Name of user record attributes used to indicate first name
+	UserAttributeMapLastName   *string                     `json:"user_attribute_map_last_name,omitempty"`   // Name of user record attributes used to indicate last name
+	NewUserMigrationTypes      *string                     `json:"new_user_migration_types,omitempty"`       // Merge first-time saml login to existing user account by email addresses. When a user logs in for the first time via saml this option will connect this user into their existing account by finding the account with a matching email address by testing the given types of credentials for existing users. Otherwise a new user account will be created for the user. This list (if provided) must be a comma separated list of string like 'email,ldap,google'
+	AlternateEmailLoginAllowed *bool                       `json:"alternate_email_login_allowed,omitempty"`  // Allow alternate email-based login via '/login/email' for admins and for specified users with the 'login_special_email' permission. This option is useful as a fallback during ldap setup, if ldap config problems occur later, or if you need to support some users who are not in your ldap directory. Looker email/password logins are always disabled for regular users when ldap is enabled.
+	TestSlug                   *string                     `json:"test_slug,omitempty"`                      // Slug to identify configurations that are created in order to run a Saml config test (read-only)
+	ModifiedAt                 *string                     `json:"modified_at,omitempty"`                    // When this config was last modified (read-only)
+	ModifiedBy                 *string                     `json:"modified_by,omitempty"`                    // User id of user who last modified this config (read-only)
+	DefaultNewUserRoleIds      *[]string                   `json:"default_new_user_role_ids,omitempty"`      // (Write-Only) Array of ids of roles that will be applied to new users the first time they login via Saml
+	DefaultNewUserRoles        *[]Role                     `json:"default_new_user_roles,omitempty"`         // (Read-only) Roles that will be applied to new users the first time they login via Saml
+	DefaultNewUserGroupIds     *[]string                   `json:"default_new_user_group_ids,omitempty"`     // (Write-Only) Array of ids of groups that will be applied to new users the first time they login via Saml
+	DefaultNewUserGroups       *[]Group                    `json:"default_new_user_groups,omitempty"`        // (Read-only) Groups that will be applied to new users the first time they login via Saml
+	SetRolesFromGroups         *bool                       `json:"set_roles_from_groups,omitempty"`          // Set user roles in Looker based on groups from Saml
+	GroupsAttribute            *string                     `json:"groups_attribute,omitempty"`               // Name of user record attributes used to indicate groups. Used when 'groups_finder_type' is set to 'grouped_attribute_values'
+	GroupsWithRoleIds          *[]AuthGroupMapping         `json:"groups_with_role_ids,omitempty"`           // (Read/Write) Array of mappings between Saml Groups and arrays of Looker Role ids
+	AuthRequiresRole           *bool                       `json:"auth_requires_role,omitempty"`             // Users will not be allowed to login at all unless a role for them is found in Saml if set to true
+	UserAttributesWithIds      *[]AuthUserAttributeMapping `json:"user_attributes_with_ids,omitempty"`       // (Read/Write) Array of mappings between Saml User Attributes and arrays of Looker User Attribute ids
+	GroupsFinderType           *string                     `json:"groups_finder_type,omitempty"`             // Identifier for a strategy for how Looker will find groups in the SAML response. One of ['grouped_attribute_values', 'individual_attributes']
+	GroupsMemberValue          *string                     `json:"groups_member_value,omitempty"`            // Value for group attribute used to indicate membership. Used when 'groups_finder_type' is set to 'individual_attributes'
+	BypassLoginPage            *bool                       `json:"bypass_login_page,omitempty"`              // Bypass the login page when user authentication is required. Redirect to IdP immediately instead.
+	AllowNormalGroupMembership *bool                       `json:"allow_normal_group_membership,omitempty"`  // Allow SAML auth'd users to be members of non-reflected Looker groups. If 'false', user will be removed from non-reflected groups on login.
+	AllowRolesFromNormalGroups *bool                       `json:"allow_roles_from_normal_groups,omitempty"` // SAML auth'd users will inherit roles from non-reflected Looker groups.
+	AllowDirectRoles           *bool                       `json:"allow_direct_roles,omitempty"`             // Allows roles to be directly assigned to SAML auth'd users.
+}
+
+// Values of SamlConfig.GroupsFinderType.
+const (
+	SamlGroupsFinderType_GROUPED_ATTRIBUTE_VALUES = "grouped_attribute_values"
+	SamlGroupsFinderType_INDIVIDUAL_ATTRIBUTES    = "individual_attributes"
+)
+
+// Get returns the SAML configuration.
+func (s *SamlConfigResourceOp) Get(ctx context.Context) (*SamlConfig, *Response, error) {
+	return doGet(ctx, s.client, SamlConfigBasePath, new(SamlConfig))
+}
+
+// Update changes the non-nil fields of the SAML configuration.
+func (s *SamlConfigResourceOp) Update(ctx context.Context, config *SamlConfig) (*SamlConfig, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPatch, SamlConfigBasePath, config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updated := new(SamlConfig)
+	resp, err := s.client.Do(ctx, req, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+	return updated, resp, err
+}
+
+// CreateTestConfig validates config without applying it. The returned configuration has a TestSlug, which can be used
+// to try logging in with it at /saml_test_login?test_slug=...
+func (s *SamlConfigResourceOp) CreateTestConfig(ctx context.Context, config *SamlConfig) (*SamlConfig, *Response, error) {
+	return doCreate(ctx, s.client, SamlTestConfigBasePath, config, new(SamlConfig))
+}
+
+// GetTestConfig returns a test configuration created by CreateTestConfig.
+func (s *SamlConfigResourceOp) GetTestConfig(ctx context.Context, testSlug string) (*SamlConfig, *Response, error) {
+	if testSlug == "" {
+		return nil, nil, NewArgError("testSlug", "cannot be empty")
+	}
+	return doGet(ctx, s.client, SamlTestConfigBasePath, new(SamlConfig), testSlug)
+}
+
+// DeleteTestConfig deletes a test configuration created by CreateTestConfig.
+func (s *SamlConfigResourceOp) DeleteTestConfig(ctx context.Context, testSlug string) (*Response, error) {
+	if testSlug == "" {
+		return nil, NewArgError("testSlug", "cannot be empty")
+	}
+	return doDelete(ctx, s.client, SamlTestConfigBasePath, testSlug)
+}
@@ -0,0 +1,150 @@
+package lookergo
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+const ScheduledPlansBasePath = "4.0/scheduled_plans"
+
+type ScheduledPlansResource interface {
+	List(context.Context, *ListOptions) ([]ScheduledPlan, *Response, error)
+	ListByUser(context.Context, string, *ListOptions) ([]ScheduledPlan, *Response, error)
+	ListByLook(context.Context, string, *ListOptions) ([]ScheduledPlan, *Response, error)
+	ListByDashboard(context.Context, string, *ListOptions) ([]ScheduledPlan, *Response, error)
+	Get(context.Context, string) (*ScheduledPlan, *Response, error)
+	Create(context.Context, *ScheduledPlan) (*ScheduledPlan, *Response, error)
+	Update(context.Context, string, *ScheduledPlan) (*ScheduledPlan, *Response, error)
+	Delete(context.Context, string) (*Response, error)
+	RunOnce(context.Context, *ScheduledPlan) (*ScheduledPlan, *Response, error)
+	RunOnceById(context.Context, string) (*ScheduledPlan, *Response, error)
+}
+
+type ScheduledPlansResourceOp struct {
+	client *Client
+}
+
+var _ ScheduledPlansResource = &ScheduledPlansResourceOp{}
+
+// Valid destination types of a ScheduledPlanDestination.
+const (
+	ScheduledPlanDestinationType_EMAIL   = "email"
+	ScheduledPlanDestinationType_WEBHOOK = "webhook"
+	ScheduledPlanDestinationType_S3      = "s3"
+	ScheduledPlanDestinationType_SFTP    = "sftp"
+)
+
+type ScheduledPlanDestination struct {
+	Id               string  `json:"id,omitempty"`                // Unique Id
+	ScheduledPlanId  string  `json:"scheduled_plan_id,omitempty"` // Id of a scheduled plan you own
+	Format           string  `json:"format"`                      // The data format to send to the given destination. Supported formats vary by destination, but include: "txt", "csv", "inline_json", "json", "json_detail", "xlsx", "html", "wysiwyg_pdf", "assembled_pdf", "wysiwyg_png"
+	ApplyFormatting  *bool   `json:"apply_formatting,omitempty"`  // Are values formatted? (containing currency symbols, digit separators, etc.
+	ApplyVis         *bool   `json:"apply_vis,omitempty"`         // Whether visualization options are applied to the results.
+	Address          string  `json:"address"`                     // Address for recipient. For email e.g. 'user@example.com'. For webhooks e.g. 'https://domain/path'. For Amazon S3 e.g. 's3://bucket-name/path/'. For SFTP e.g. 'sftp://host-name/path/'.
+	LookerRecipient  bool    `json:"looker_recipient,omitempty"`  // Whether the recipient is a Looker user on the current instance (only applicable for email recipients)
+	Type             string  `json:"type"`                        // Type of the address ('email', 'webhook', 's3', or 'sftp')
+	Parameters       *string `json:"parameters,omitempty"`        // JSON object containing parameters for external scheduling. For Amazon S3, this requires keys and values for access_key_id and region. For SFTP, this requires a key and value for username.
+	SecretParameters *string `json:"secret_parameters,omitempty"` // (Write-Only) JSON object containing secret parameters for external scheduling. For Amazon S3, this requires a key and value for secret_access_key. For SFTP, this requires a key and value for password.
+	Message          *string `json:"message,omitempty"`           // Optional message to be included in scheduled emails
+}
+
+type ScheduledPlan struct {
+	Id                       string                     `json:"id,omitempty"`                         // Unique Id
+	Name                     string                     `json:"name,omitempty"`                       // Name of this scheduled plan
+	UserId                   string                     `json:"user_id,omitempty"`                    // User Id which owns this scheduled plan
+	RunAsRecipient           *bool                      `json:"run_as_recipient,omitempty"`           // Whether schedule is run as recipient (only applicable for email recipients)
+	Enabled                  *bool                      `json:"enabled,omitempty"`                    // Whether the ScheduledPlan is enabled
+	LookId                   string                     `json:"look_id,omitempty"`                    // Id of a look
+	DashboardId              string                     `json:"dashboard_id,omitempty"`               // Id of a dashboard
+	LookmlDashboardId        string                     `json:"lookml_dashboard_id,omitempty"`        // Id of a LookML dashboard
+	FiltersString            *string                    `json:"filters_string,omitempty"`             // Query string to run look or dashboard with
+	DashboardFilters         *string                    `json:"dashboard_filters,omitempty"`          // (DEPRECATED) Alias for filters_string field
+	RequireResults           *bool                      `json:"require_results,omitempty"`            // Delivery should occur if running the dashboard or look returns results
+	RequireNoResults         *bool                      `json:"require_no_results,omitempty"`         // Delivery should occur if the dashboard look does not return results
+	RequireChange            *bool                      `json:"require_change,omitempty"`             // Delivery should occur if data have changed since the last run
+	SendAllResults           *bool                      `json:"send_all_results,omitempty"`           // Will run an unlimited query and send all results.
+	Crontab                  *string                    `json:"crontab,omitempty"`                    // Vixie-Style crontab specification when to run
+	Datagroup                *string                    `json:"datagroup,omitempty"`                  // Name of a datagroup; if specified will run when datagroup triggered (can't be used with cron string)
+	Timezone                 *string                    `json:"timezone,omitempty"`                   // Timezone for interpreting the specified crontab (default is Looker instance timezone)
+	QueryId                  string                     `json:"query_id,omitempty"`                   // Query id
+	ScheduledPlanDestination []ScheduledPlanDestination `json:"scheduled_plan_destination,omitempty"` // Scheduled plan destinations
+	RunOnce                  *bool                      `json:"run_once,omitempty"`                   // Whether the plan in question should only be run once (usually for testing)
+	IncludeLinks             *bool                      `json:"include_links,omitempty"`              // Whether links back to Looker should be included in this ScheduledPlan
+	PdfPaperSize             *string                    `json:"pdf_paper_size,omitempty"`             // The size of paper the PDF should be formatted to fit. Valid values are: "letter", "legal", "tabloid", "a0", "a1", "a2", "a3", "a4", "a5".
+	PdfLandscape             *bool                      `json:"pdf_landscape,omitempty"`              // Whether the PDF should be formatted for landscape orientation
+	LongTables               *bool                      `json:"long_tables,omitempty"`                // Whether or not to expand table vis to full length
+	InlineTableWidth         *int64                     `json:"inline_table_width,omitempty"`         // The pixel width at which we render the inline table visualizations
+	Title                    string                     `json:"title,omitempty"`                      // Title
+	CreatedAt                *time.Time                 `json:"created_at,omitempty"`                 // Date and time when ScheduledPlan was created
+	UpdatedAt                *time.Time                 `json:"updated_at,omitempty"`                 // Date and time when ScheduledPlan was last updated
+	NextRunAt                *time.Time                 `json:"next_run_at,omitempty"`                // When the ScheduledPlan will next run (null if running once)
+	LastRunAt                *time.Time                 `json:"last_run_at,omitempty"`                // When the ScheduledPlan was last run
+	Can                      map[string]bool            `json:"can,omitempty"`                        // Operations the current user is able to perform on this object
+}
+
+// List returns the scheduled plans of all users.
+func (s *ScheduledPlansResourceOp) List(ctx context.Context, opt *ListOptions) ([]ScheduledPlan, *Response, error) {
+	qs := url.Values{}
+	qs.Add("all_users", "true")
+
+	return doListByX(ctx, s.client, ScheduledPlansBasePath, opt, new([]ScheduledPlan), qs)
+}
+
+// ListByUser returns the scheduled plans owned by the user with the given id.
+func (s *ScheduledPlansResourceOp) ListByUser(ctx context.Context, UserId string, opt *ListOptions) ([]ScheduledPlan, *Response, error) {
+	if UserId == "" {
+		return nil, nil, NewArgError("UserId", "has to be non-empty")
+	}
+	qs := url.Values{}
+	qs.Add("user_id", UserId)
+
+	return doListByX(ctx, s.client, ScheduledPlansBasePath, opt, new([]ScheduledPlan), qs)
+}
+
+// ListByLook returns the scheduled plans of all users for the look with the given id.
+func (s *ScheduledPlansResourceOp) ListByLook(ctx context.Context, LookId string, opt *ListOptions) ([]ScheduledPlan, *Response, error) {
+	qs := url.Values{}
+	qs.Add("all_users", "true")
+
+	path := fmt.Sprintf("%s/look/%s", ScheduledPlansBasePath, LookId)
+
+	return doListByX(ctx, s.client, path, opt, new([]ScheduledPlan), qs)
+}
+
+// ListByDashboard returns the scheduled plans of all users for the dashboard with the given id.
+func (s *ScheduledPlansResourceOp) ListByDashboard(ctx context.Context, DashboardId string, opt *ListOptions) ([]ScheduledPlan, *Response, error) {
+	qs := url.Values{}
+	qs.Add("all_users", "true")
+
+	path := fmt.Sprintf("%s/dashboard/%s", ScheduledPlansBasePath, DashboardId)
+
+	return doListByX(ctx, s.client, path, opt, new([]ScheduledPlan), qs)
+}
+
+func (s *ScheduledPlansResourceOp) Get(ctx context.Context, ScheduledPlanId string) (*ScheduledPlan, *Response, error) {
+	return doGetById(ctx, s.client, ScheduledPlansBasePath, ScheduledPlanId, new(ScheduledPlan))
+}
+
+func (s *ScheduledPlansResourceOp) Create(ctx context.Context, requestScheduledPlan *ScheduledPlan) (*ScheduledPlan, *Response, error) {
+	return doCreate(ctx, s.client, ScheduledPlansBasePath, requestScheduledPlan, new(ScheduledPlan))
+}
+
+func (s *ScheduledPlansResourceOp) Update(ctx context.Context, ScheduledPlanId string, requestScheduledPlan *ScheduledPlan) (*ScheduledPlan, *Response, error) {
+	return doUpdate(ctx, s.client, ScheduledPlansBasePath, ScheduledPlanId, requestScheduledPlan, new(ScheduledPlan))
+}
+
+func (s *ScheduledPlansResourceOp) Delete(ctx context.Context, ScheduledPlanId string) (*Response, error) {
+	return doDelete(ctx, s.client, ScheduledPlansBasePath, ScheduledPlanId)
+}
+
+// RunOnce runs the given scheduled plan immediately, without saving it.
+func (s *ScheduledPlansResourceOp) RunOnce(ctx context.Context, requestScheduledPlan *ScheduledPlan) (*ScheduledPlan, *Response, error) {
+	return doCreate(ctx, s.client, ScheduledPlansBasePath, requestScheduledPlan, new(ScheduledPlan), "run_once")
+}
+
+// RunOnceById runs the existing scheduled plan with the given id immediately.
+func (s *ScheduledPlansResourceOp) RunOnceById(ctx context.Context, ScheduledPlanId string) (*ScheduledPlan, *Response, error) {
+	return doEmptyPost(ctx, s.client, ScheduledPlansBasePath, new(ScheduledPlan), ScheduledPlanId, "run_once")
+}
@@ -0,0 +1,222 @@
+package lookergo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestScheduledPlansResourceOp_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/4.0/scheduled_plans/21", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id":"21","name":"Daily revenue","user_id":"4","look_id":"7","crontab":"0 6 * * *",
+			"run_as_recipient":false,"enabled":true,
+			"scheduled_plan_destination":[{"id":"31","scheduled_plan_id":"21","format":"csv","type":"email","address":"team@example.com","looker_recipient":true}]}`)
+	})
+
+	plan, _, err := client.ScheduledPlans.Get(ctx, "21")
+	if err != nil {
+		t.Fatalf("ScheduledPlans.Get returned error: %v", err)
+	}
+
+	expected := &ScheduledPlan{
+		Id:             "21",
+		Name:           "Daily revenue",
+		UserId:         "4",
+		LookId:         "7",
+		Crontab:        String("0 6 * * *"),
+		RunAsRecipient: Bool(false),
+		Enabled:        Bool(true),
+		ScheduledPlanDestination: []ScheduledPlanDestination{
+			{Id: "31", ScheduledPlanId: "21", Format: "csv", Type: ScheduledPlanDestinationType_EMAIL, Address: "team@example.com", LookerRecipient: true},
+		},
+	}
+	if !reflect.DeepEqual(plan, expected) {
+		t.Error(errGotWant("ScheduledPlans.Get", plan, expected))
+	}
+}
+
+func TestScheduledPlansResourceOp_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	request := &ScheduledPlan{
+		Name:        "Weekly export",
+		DashboardId: "12",
+		Crontab:     String("0 8 * * 1"),
+		ScheduledPlanDestination: []ScheduledPlanDestination{
+			{
+				Format:           "csv_zip",
+				Type:             ScheduledPlanDestinationType_S3,
+				Address:          "s3://bucket/exports/",
+				Parameters:       String(`{"region":"eu-west-1","access_key_id":"AKIA"}`),
+				SecretParameters: String(`{"secret_access_key":"secret"}`),
+			},
+		},
+	}
+
+	mux.HandleFunc("/4.0/scheduled_plans", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		body := new(ScheduledPlan)
+		if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+			t.Fatalf("decode request body: %v", err)
+		}
+		if !reflect.DeepEqual(body, request) {
+			t.Error(errGotWant("Request body", body, request))
+		}
+		fmt.Fprint(w, `{"id":"22","name":"Weekly export","dashboard_id":"12"}`)
+	})
+
+	plan, _, err := client.ScheduledPlans.Create(ctx, request)
+	if err != nil {
+		t.Fatalf("ScheduledPlans.Create returned error: %v", err)
+	}
+	if plan.Id != "22" {
+		t.Errorf("ScheduledPlans.Create returned id %q, expected %q", plan.Id, "22")
+	}
+}
+
+func TestScheduledPlansResourceOp_Update(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/4.0/scheduled_plans/21", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPatch)
+		fmt.Fprint(w, `{"id":"21","name":"Daily revenue","enabled":false}`)
+	})
+
+	plan, _, err := client.ScheduledPlans.Update(ctx, "21", &ScheduledPlan{Enabled: Bool(false)})
+	if err != nil {
+		t.Fatalf("ScheduledPlans.Update returned error: %v", err)
+	}
+	if plan.Enabled == nil || *plan.Enabled {
+		t.Errorf("ScheduledPlans.Update returned enabled %v, expected false", plan.Enabled)
+	}
+}
+
+func TestScheduledPlansResourceOp_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/4.0/scheduled_plans/21", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.ScheduledPlans.Delete(ctx, "21"); err != nil {
+		t.Errorf("ScheduledPlans.Delete returned error: %v", err)
+	}
+}
+
+func TestScheduledPlansResourceOp_ListByUser(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/4.0/scheduled_plans", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testFormValues(t, r, values{"user_id": "4", "limit": "500"})
+		fmt.Fprint(w, `[{"id":"21","user_id":"4"},{"id":"22","user_id":"4"}]`)
+	})
+
+	plans, _, err := client.ScheduledPlans.ListByUser(ctx, "4", nil)
+	if err != nil {
+		t.Fatalf("ScheduledPlans.ListByUser returned error: %v", err)
+	}
+
+	expected := []ScheduledPlan{{Id: "21", UserId: "4"}, {Id: "22", UserId: "4"}}
+	if !reflect.DeepEqual(plans, expected) {
+		t.Error(errGotWant("ScheduledPlans.ListByUser", plans, expected))
+	}
+
+	if _, _, err = client.ScheduledPlans.ListByUser(ctx, "", nil); err == nil {
+		t.Error("ScheduledPlans.ListByUser expected an error for an empty user id")
+	}
+}
+
+func TestScheduledPlansResourceOp_ListByLook(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/4.0/scheduled_plans/look/7", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testFormValues(t, r, values{"all_users": "true", "limit": "500"})
+		fmt.Fprint(w, `[{"id":"21","look_id":"7"}]`)
+	})
+
+	plans, _, err := client.ScheduledPlans.ListByLook(ctx, "7", nil)
+	if err != nil {
+		t.Fatalf("ScheduledPlans.ListByLook returned error: %v", err)
+	}
+
+	expected := []ScheduledPlan{{Id: "21", LookId: "7"}}
+	if !reflect.DeepEqual(plans, expected) {
+		t.Error(errGotWant("ScheduledPlans.ListByLook", plans, expected))
+	}
+}
+
+func TestScheduledPlansResourceOp_ListByDashboard(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/4.0/scheduled_plans/dashboard/12", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testFormValues(t, r, values{"all_users": "true", "limit": "500"})
+		fmt.Fprint(w, `[{"id":"22","dashboard_id":"12"}]`)
+	})
+
+	plans, _, err := client.ScheduledPlans.ListByDashboard(ctx, "12", nil)
+	if err != nil {
+		t.Fatalf("ScheduledPlans.ListByDashboard returned error: %v", err)
+	}
+
+	expected := []ScheduledPlan{{Id: "22", DashboardId: "12"}}
+	if !reflect.DeepEqual(plans, expected) {
+		t.Error(errGotWant("ScheduledPlans.ListByDashboard", plans, expected))
+	}
+}
+
+func TestScheduledPlansResourceOp_RunOnce(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/4.0/scheduled_plans/run_once", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{"id":"23","look_id":"7","run_once":true}`)
+	})
+
+	plan, _, err := client.ScheduledPlans.RunOnce(ctx, &ScheduledPlan{
+		LookId: "7",
+		ScheduledPlanDestination: []ScheduledPlanDestination{
+			{Format: "csv", Type: ScheduledPlanDestinationType_WEBHOOK, Address: "https://example.com/hook"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("ScheduledPlans.RunOnce returned error: %v", err)
+	}
+	if plan.RunOnce == nil || !*plan.RunOnce {
+		t.Errorf("ScheduledPlans.RunOnce returned run_once %v, expected true", plan.RunOnce)
+	}
+}
+
+func TestScheduledPlansResourceOp_RunOnceById(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/4.0/scheduled_plans/21/run_once", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{"id":"24","run_once":true}`)
+	})
+
+	plan, _, err := client.ScheduledPlans.RunOnceById(ctx, "21")
+	if err != nil {
+		t.Fatalf("ScheduledPlans.RunOnceById returned error: %v", err)
+	}
+	if plan.Id != "24" {
+		t.Errorf("ScheduledPlans.RunOnceById returned id %q, expected %q", plan.Id, "24")
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	"golang.org/x/oauth2"
 )
@@ -15,6 +16,7 @@ type SessionsResource interface {
 	SetWorkspaceId(ctx context.Context, workspaceId string) (*Session, *Response, error)
 	GetCurrentUser(ctx context.Context) (*User, *Response, error)
 	GetLoginUserToken(ctx context.Context, userId string) (*oauth2.Token, *Response, error)
+	Logout(ctx context.Context) (*Response, error)
 }
 
 type SessionsResourceOp struct {
@@ -54,7 +56,8 @@ func (s *SessionsResourceOp) GetCurrentUser(ctx context.Context) (*User, *Respon
 	return doGet(ctx, s.client, "4.0/user", new(User))
 }
 
-// GetLoginUserToken -
+// GetLoginUserToken logs in as the given user and returns the token of the new API session. Its Expiry is computed from
+// the expires_in of the response.
 func (s *SessionsResourceOp) GetLoginUserToken(ctx context.Context, userId string) (*oauth2.Token, *Response, error) {
 	path := fmt.Sprintf("4.0/login/%s", userId)
 
@@ -63,11 +66,25 @@ func (s *SessionsResourceOp) GetLoginUserToken(ctx context.Context, userId strin
 		return nil, nil, err
 	}
 
-	token := new(oauth2.Token)
-	resp, err := s.client.Do(ctx, req, &token)
+	authToken := new(AuthToken)
+	resp, err := s.client.Do(ctx, req, authToken)
 	if err != nil {
 		return nil, resp, err
 	}
 
-	return token, nil, nil
+	token := &oauth2.Token{AccessToken: authToken.AccessToken, TokenType: authToken.TokenType}
+	if authToken.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(authToken.ExpiresIn) * time.Second)
+	}
+	return token, resp, nil
+}
+
+// Logout ends the API session of the client, invalidating its token.
+func (s *SessionsResourceOp) Logout(ctx context.Context) (*Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, "4.0/logout", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
 }
@@ -37,6 +37,7 @@ type UserAttributeGroupValue struct {
 }
 
 type UserAttributesResource interface {
+	List(context.Context, *ListOptions) ([]UserAttribute, *Response, error)
 	Get(context.Context, int) (*UserAttribute, *Response, error)
 	Create(context.Context, *UserAttribute) (*UserAttribute, *Response, error)
 	Update(context.Context, string, *UserAttribute) (*UserAttribute, *Response, error)
@@ -45,6 +46,10 @@ type UserAttributesResource interface {
 	GetUserAttributeValue(context.Context, string) (*[]UserAttributeGroupValue, *Response, error)
 }
 
+func (s *UserAttributesResourceOp) List(ctx context.Context, opt *ListOptions) ([]UserAttribute, *Response, error) {
+	return doList(ctx, s.client, UserAttributesBasePath, opt, new([]UserAttribute))
+}
+
 func (s *UserAttributesResourceOp) Get(ctx context.Context, UserAttributeId int) (*UserAttribute, *Response, error) {
 	return doGetById(ctx, s.client, UserAttributesBasePath, UserAttributeId, new(UserAttribute))
 }
@@ -24,6 +24,79 @@ type CredentialsEmail struct {
 	UserUrl                        string          `json:"user_url,omitempty"`                            // Link to get this user
 }
 
+// CredentialsApi3 is a client id and secret pair a user can log in to the API with.
+type CredentialsApi3 struct {
+	Can          map[string]bool `json:"can,omitempty"`           // Operations the current user is able to perform on this object
+	ClientId     string          `json:"client_id,omitempty"`     // API key client_id
+	ClientSecret string          `json:"client_secret,omitempty"` // API key client_secret, only returned on creation
+	CreatedAt    string          `json:"created_at,omitempty"`    // Timestamp for the creation of this credential
+	Id           string          `json:"id,omitempty"`            // Unique Id
+	IsDisabled   bool            `json:"is_disabled,omitempty"`   // Has this credential been disabled?
+	Type         string          `json:"type,omitempty"`          // Short name for the type of this kind of credential
+	Url          string          `json:"url,omitempty"`           // Link to get this item
+}
+
+// CredentialsGoogle links a user to a Google account.
+type CredentialsGoogle struct {
+	Can          map[string]bool `json:"can,omitempty"`            // Operations the current user is able to perform on this object
+	CreatedAt    string          `json:"created_at,omitempty"`     // Timestamp for the creation of this credential
+	Domain       string          `json:"domain,omitempty"`         // Google domain
+	Email        string          `json:"email,omitempty"`          // EMail address
+	GoogleUserId string          `json:"google_user_id,omitempty"` // Google's Unique ID for this user
+	IsDisabled   bool            `json:"is_disabled,omitempty"`    // Has this credential been disabled?
+	LoggedInAt   string          `json:"logged_in_at,omitempty"`   // Timestamp for most recent login using credential
+	Type         string          `json:"type,omitempty"`           // Short name for the type of this kind of credential
+	Url          string          `json:"url,omitempty"`            // Link to get this item
+}
+
+// CredentialsLDAP links a user to an LDAP record.
+type CredentialsLDAP struct {
+	Can        map[string]bool `json:"can,omitempty"`          // Operations the current user is able to perform on this object
+	CreatedAt  string          `json:"created_at,omitempty"`   // Timestamp for the creation of this credential
+	Email      string          `json:"email,omitempty"`        // EMail address
+	IsDisabled bool            `json:"is_disabled,omitempty"`  // Has this credential been disabled?
+	LdapDn     string          `json:"ldap_dn,omitempty"`      // LDAP Distinguished name for this user (as-of the last login)
+	LdapId     string          `json:"ldap_id,omitempty"`      // LDAP Unique ID for this user
+	LoggedInAt string          `json:"logged_in_at,omitempty"` // Timestamp for most recent login using credential
+	Type       string          `json:"type,omitempty"`         // Short name for the type of this kind of credential
+	Url        string          `json:"url,omitempty"`          // Link to get this item
+}
+
+// CredentialsLookerOpenid links a user to a Looker employee OpenID account.
+type CredentialsLookerOpenid struct {
+	Can        map[string]bool `json:"can,omitempty"`          // Operations the current user is able to perform on this object
+	CreatedAt  string          `json:"created_at,omitempty"`   // Timestamp for the creation of this credential
+	Email      string          `json:"email,omitempty"`        // EMail address used for user login
+	IsDisabled bool            `json:"is_disabled,omitempty"`  // Has this credential been disabled?
+	LoggedInAt string          `json:"logged_in_at,omitempty"` // Timestamp for most recent login using credential
+	LoggedInIp string          `json:"logged_in_ip,omitempty"` // IP address of client for most recent login using credential
+	Type       string          `json:"type,omitempty"`         // Short name for the type of this kind of credential
+	Url        string          `json:"url,omitempty"`          // Link to get this item
+	UserUrl    string          `json:"user_url,omitempty"`     // Link to get this user
+}
+
+// CredentialsOIDC links a user to an OpenID Connect identity.
+type CredentialsOIDC struct {
+	Can        map[string]bool `json:"can,omitempty"`          // Operations the current user is able to perform on this object
+	CreatedAt  string          `json:"created_at,omitempty"`   // Timestamp for the creation of this credential
+	Email      string          `json:"email,omitempty"`        // EMail address
+	IsDisabled bool            `json:"is_disabled,omitempty"`  // Has this credential been disabled?
+	LoggedInAt string          `json:"logged_in_at,omitempty"` // Timestamp for most recent login using credential
+	OidcUserId string          `json:"oidc_user_id,omitempty"` // OIDC OP's Unique ID for this user
+	Type       string          `json:"type,omitempty"`         // Short name for the type of this kind of credential
+	Url        string          `json:"url,omitempty"`          // Link to get this item
+}
+
+// CredentialsTotp is the two-factor authentication setup of a user.
+type CredentialsTotp struct {
+	Can        map[string]bool `json:"can,omitempty"`         // Operations the current user is able to perform on this object
+	CreatedAt  string          `json:"created_at,omitempty"`  // Timestamp for the creation of this credential
+	IsDisabled bool            `json:"is_disabled,omitempty"` // Has this credential been disabled?
+	Type       string          `json:"type,omitempty"`        // Short name for the type of this kind of credential
+	Verified   bool            `json:"verified,omitempty"`    // User has verified
+	Url        string          `json:"url,omitempty"`         // Link to get this item
+}
+
 type CredentialsEmbed struct {
 	Can             map[string]bool `json:"can,omitempty"`               // Operations the current user is able to perform on this object
 	CreatedAt       string          `json:"created_at,omitempty"`        // Timestamp for the creation of this credential
@@ -54,41 +127,41 @@ type SliceStringInts []int
 // User defines a user in the database
 // Ref: https://github.com/looker-open-source/sdk-codegen/blob/main/go/sdk/v4/models.go#L3508
 type User struct {
-	Can                    *map[string]bool    `json:"can,omitempty"`                       // Operations the current user is able to perform on this object
-	AvatarUrl              string              `json:"avatar_url,omitempty"`                // URL for the avatar image (may be generic)
-	AvatarUrlWithoutSizing string              `json:"avatar_url_without_sizing,omitempty"` // URL for the avatar image (may be generic), does not specify size
-	CredentialsEmail       *CredentialsEmail   `json:"credentials_email,omitempty"`
-	CredentialsEmbed       *[]CredentialsEmbed `json:"credentials_embed,omitempty"` // Embed credentials
-	//CredentialsApi3            *[]CredentialsApi3       `json:"credentials_api3,omitempty"`                // API 3 credentials
-	//CredentialsGoogle          *CredentialsGoogle       `json:"credentials_google,omitempty"`
-	//CredentialsLdap            *CredentialsLDAP         `json:"credentials_ldap,omitempty"`
-	//CredentialsLookerOpenid    *CredentialsLookerOpenid `json:"credentials_looker_openid,omitempty"`
-	//CredentialsOidc            *CredentialsOIDC         `json:"credentials_oidc,omitempty"`
-	//CredentialsTotp            *CredentialsTotp         `json:"credentials_totp,omitempty"`
-	CredentialsSaml            *CredentialsSaml       `json:"credentials_saml,omitempty"`
-	DisplayName                string                 `json:"display_name,omitempty"`                   // Full name for display (available only if both first_name and last_name are set)
-	Email                      string                 `json:"email,omitempty"`                          // EMail address
-	EmbedGroupSpaceId          string                 `json:"embed_group_space_id,omitempty"`           // (DEPRECATED) (Embed only) ID of user's group space based on the external_group_id optionally specified during embed user login
-	FirstName                  string                 `json:"first_name,omitempty"`                     // First name
-	GroupIds                   []string               `json:"group_ids,omitempty"`                      // Array of ids of the groups for this user
-	HomeFolderId               string                 `json:"home_folder_id,omitempty"`                 // ID string for user's home folder
-	Id                         string                 `json:"id,omitempty"`                             // Unique Id
-	IsDisabled                 bool                   `json:"is_disabled,omitempty"`                    // Account has been disabled
-	LastName                   string                 `json:"last_name,omitempty"`                      // Last name
-	Locale                     string                 `json:"locale,omitempty"`                         // User's preferred locale. User locale takes precedence over Looker's system-wide default locale. Locale determines language of display strings and date and numeric formatting in API responses. Locale string must be a 2 letter language code or a combination of language code and region code: 'en' or 'en-US', for example.
-	LookerVersions             []string               `json:"looker_versions,omitempty"`                // Array of strings representing the Looker versions that this user has used (this only goes back as far as '3.54.0')
-	ModelsDirValidated         bool                   `json:"models_dir_validated,omitempty"`           // User's dev workspace has been checked for presence of applicable production projects
-	PersonalFolderId           string                 `json:"personal_folder_id,omitempty"`             // ID of user's personal folder
-	PresumedLookerEmployee     bool                   `json:"presumed_looker_employee,omitempty"`       // User is identified as an employee of Looker
-	RoleIds                    SliceStringInts        `json:"role_ids,omitempty"`                       // Array of ids of the roles for this user
-	UiState                    map[string]interface{} `json:"ui_state,omitempty"`                       // Per user dictionary of undocumented state information owned by the Looker UI.
-	VerifiedLookerEmployee     bool                   `json:"verified_looker_employee,omitempty"`       // User is identified as an employee of Looker who has been verified via Looker corporate authentication
-	RolesExternallyManaged     bool                   `json:"roles_externally_managed,omitempty"`       // User's roles are managed by an external directory like SAML or LDAP and can not be changed directly.
-	AllowDirectRoles           bool                   `json:"allow_direct_roles,omitempty"`             // User can be directly assigned a role.
-	AllowNormalGroupMembership bool                   `json:"allow_normal_group_membership,omitempty"`  // User can be a direct member of a normal Looker group.
-	AllowRolesFromNormalGroups bool                   `json:"allow_roles_from_normal_groups,omitempty"` // User can inherit roles from a normal Looker group.
-	EmbedGroupFolderId         string                 `json:"embed_group_folder_id,omitempty"`          // (Embed only) ID of user's group folder based on the external_group_id optionally specified during embed user login
-	Url                        string                 `json:"url,omitempty"`                            // Link to get this item
+	Can                        *map[string]bool         `json:"can,omitempty"`                       // Operations the current user is able to perform on this object
+	AvatarUrl                  string                   `json:"avatar_url,omitempty"`                // URL for the avatar image (may be generic)
+	AvatarUrlWithoutSizing     string                   `json:"avatar_url_without_sizing,omitempty"` // URL for the avatar image (may be generic), does not specify size
+	CredentialsEmail           *CredentialsEmail        `json:"credentials_email,omitempty"`
+	CredentialsEmbed           *[]CredentialsEmbed      `json:"credentials_embed,omitempty"` // Embed credentials
+	CredentialsApi3            *[]CredentialsApi3       `json:"credentials_api3,omitempty"`  // API 3 credentials
+	CredentialsGoogle          *CredentialsGoogle       `json:"credentials_google,omitempty"`
+	CredentialsLdap            *CredentialsLDAP         `json:"credentials_ldap,omitempty"`
+	CredentialsLookerOpenid    *CredentialsLookerOpenid `json:"credentials_looker_openid,omitempty"`
+	CredentialsOidc            *CredentialsOIDC         `json:"credentials_oidc,omitempty"`
+	CredentialsTotp            *CredentialsTotp         `json:"credentials_totp,omitempty"`
+	CredentialsSaml            *CredentialsSaml         `json:"credentials_saml,omitempty"`
+	DisplayName                string                   `json:"display_name,omitempty"`                   // Full name for display (available only if both first_name and last_name are set)
+	Email                      string                   `json:"email,omitempty"`                          // EMail address
+	EmbedGroupSpaceId          string                   `json:"embed_group_space_id,omitempty"`           // (DEPRECATED) (Embed only) ID of user's group space based on the external_group_id optionally specified during embed user login
+	FirstName                  string                   `json:"first_name,omitempty"`                     // First name
+	GroupIds                   []string                 `json:"group_ids,omitempty"`                      // Array of ids of the groups for this user
+	HomeFolderId               string                   `json:"home_folder_id,omitempty"`                 // ID string for user's home folder
+	Id                         string                   `json:"id,omitempty"`                             // Unique Id
+	IsDisabled                 bool                     `json:"is_disabled,omitempty"`                    // Account has been disabled
+	LastName                   string                   `json:"last_name,omitempty"`                      // Last name
+	Locale                     string                   `json:"locale,omitempty"`                         // User's preferred locale. User locale takes precedence over Looker's system-wide default locale. Locale determines language of display strings and date and numeric formatting in API responses. Locale string must be a 2 letter language code or a combination of language code and region code: 'en' or 'en-US', for example.
+	LookerVersions             []string                 `json:"looker_versions,omitempty"`                // Array of strings representing the Looker versions that this user has used (this only goes back as far as '3.54.0')
+	ModelsDirValidated         bool                     `json:"models_dir_validated,omitempty"`           // User's dev workspace has been checked for presence of applicable production projects
+	PersonalFolderId           string                   `json:"personal_folder_id,omitempty"`             // ID of user's personal folder
+	PresumedLookerEmployee     bool                     `json:"presumed_looker_employee,omitempty"`       // User is identified as an employee of Looker
+	RoleIds                    SliceStringInts          `json:"role_ids,omitempty"`                       // Array of ids of the roles for this user
+	UiState                    map[string]interface{}   `json:"ui_state,omitempty"`                       // Per user dictionary of undocumented state information owned by the Looker UI.
+	VerifiedLookerEmployee     bool                     `json:"verified_looker_employee,omitempty"`       // User is identified as an employee of Looker who has been verified via Looker corporate authentication
+	RolesExternallyManaged     bool                     `json:"roles_externally_managed,omitempty"`       // User's roles are managed by an external directory like SAML or LDAP and can not be changed directly.
+	AllowDirectRoles           bool                     `json:"allow_direct_roles,omitempty"`             // User can be directly assigned a role.
+	AllowNormalGroupMembership bool                     `json:"allow_normal_group_membership,omitempty"`  // User can be a direct member of a normal Looker group.
+	AllowRolesFromNormalGroups bool                     `json:"allow_roles_from_normal_groups,omitempty"` // User can inherit roles from a normal Looker group.
+	EmbedGroupFolderId         string                   `json:"embed_group_folder_id,omitempty"`          // (Embed only) ID of user's group folder based on the external_group_id optionally specified during embed user login
+	Url                        string                   `json:"url,omitempty"`                            // Link to get this item
 }
 
 // JSON parsing
@@ -157,6 +230,7 @@ type UsersResource interface {
 	List(context.Context, *ListOptions) ([]User, *Response, error)
 	ListById(context.Context, []string, *ListOptions) ([]User, *Response, error)
 	ListByEmail(context.Context, string, *ListOptions) ([]User, *Response, error)
+	Search(context.Context, *UserSearch, *ListOptions) ([]User, *Response, error)
 	Get(context.Context, string) (*User, *Response, error)
 	Create(context.Context, *User) (*User, *Response, error)
 	Update(context.Context, string, *User) (*User, *Response, error)
@@ -165,9 +239,30 @@ type UsersResource interface {
 	GetEmail(context.Context, string) (*CredentialsEmail, *Response, error)
 	UpdateEmail(context.Context, string, *CredentialsEmail) (*CredentialsEmail, *Response, error)
 	DeleteEmail(context.Context, string) (*Response, error)
+	ListApi3(context.Context, string) ([]CredentialsApi3, *Response, error)
+	CreateApi3(context.Context, string) (*CredentialsApi3, *Response, error)
+	GetApi3(context.Context, string, string) (*CredentialsApi3, *Response, error)
+	DeleteApi3(context.Context, string, string) (*Response, error)
+	ListEmbed(context.Context, string) ([]CredentialsEmbed, *Response, error)
+	GetEmbed(context.Context, string, string) (*CredentialsEmbed, *Response, error)
+	DeleteEmbed(context.Context, string, string) (*Response, error)
+	GetGoogle(context.Context, string) (*CredentialsGoogle, *Response, error)
+	DeleteGoogle(context.Context, string) (*Response, error)
+	GetLDAP(context.Context, string) (*CredentialsLDAP, *Response, error)
+	DeleteLDAP(context.Context, string) (*Response, error)
+	GetLookerOpenid(context.Context, string) (*CredentialsLookerOpenid, *Response, error)
+	DeleteLookerOpenid(context.Context, string) (*Response, error)
+	GetOIDC(context.Context, string) (*CredentialsOIDC, *Response, error)
+	DeleteOIDC(context.Context, string) (*Response, error)
+	GetSaml(context.Context, string) (*CredentialsSaml, *Response, error)
+	DeleteSaml(context.Context, string) (*Response, error)
+	CreateTotp(context.Context, string) (*CredentialsTotp, *Response, error)
+	GetTotp(context.Context, string) (*CredentialsTotp, *Response, error)
+	DeleteTotp(context.Context, string) (*Response, error)
 	CreatePasswordReset(context.Context, string) (*CredentialsEmail, *Response, error)
 	SendPasswordReset(context.Context, string) (*CredentialsEmail, *Response, error)
 	GetRoles(context.Context, string) ([]Role, *Response, error)
+	GetDirectRoles(context.Context, string) ([]Role, *Response, error)
 	SetRoles(context.Context, string, []string) ([]Role, *Response, error)
 }
 
@@ -216,6 +311,22 @@ func (s *UsersResourceOp) ListByEmail(ctx context.Context, email string, opt *Li
 	return doListByX(ctx, s.client, path, opt, new([]User), qs)
 }
 
+// UserSearch filters the users returned by Search. The string filters support the wildcards % (any characters) and
+// _ (any single character), e.g. Email "%@example.com".
+type UserSearch struct {
+	FirstName  string `url:"first_name,omitempty"`
+	LastName   string `url:"last_name,omitempty"`
+	Email      string `url:"email,omitempty"`
+	IsDisabled *bool  `url:"is_disabled,omitempty"`
+	GroupId    string `url:"group_id,omitempty"` // Users who are direct members of the group
+	Sorts      string `url:"sorts,omitempty"`    // Fields to sort by, e.g. "last_name, first_name"
+}
+
+// Search users matching all the given filters. Without limit/offset options, all pages are retrieved.
+func (s *UsersResourceOp) Search(ctx context.Context, search *UserSearch, opt *ListOptions) ([]User, *Response, error) {
+	return doSearch(ctx, s.client, userBasePath, search, opt, new([]User))
+}
+
 // Get -
 func (s *UsersResourceOp) Get(ctx context.Context, id string) (*User, *Response, error) {
 	return doGetById(ctx, s.client, userBasePath, id, new(User))
@@ -256,6 +367,106 @@ func (s *UsersResourceOp) DeleteEmail(ctx context.Context, id string) (*Response
 	return doDelete(ctx, s.client, userBasePath, id, "credentials_email")
 }
 
+// ListApi3 lists the API3 credentials of the user, without their client secret.
+func (s *UsersResourceOp) ListApi3(ctx context.Context, id string) ([]CredentialsApi3, *Response, error) {
+	return doList(ctx, s.client, userBasePath, nil, new([]CredentialsApi3), id, "credentials_api3")
+}
+
+// CreateApi3 creates new API3 credentials for the user. The client secret is only returned here.
+func (s *UsersResourceOp) CreateApi3(ctx context.Context, id string) (*CredentialsApi3, *Response, error) {
+	return doEmptyPost(ctx, s.client, userBasePath, new(CredentialsApi3), id, "credentials_api3")
+}
+
+// GetApi3 gets API3 credentials of the user, without their client secret.
+func (s *UsersResourceOp) GetApi3(ctx context.Context, id string, credentialsId string) (*CredentialsApi3, *Response, error) {
+	return doGet(ctx, s.client, userBasePath, new(CredentialsApi3), id, "credentials_api3", credentialsId)
+}
+
+// DeleteApi3 deletes API3 credentials of the user.
+func (s *UsersResourceOp) DeleteApi3(ctx context.Context, id string, credentialsId string) (*Response, error) {
+	return doDelete(ctx, s.client, userBasePath, id, "credentials_api3", credentialsId)
+}
+
+// ListEmbed lists the embed credentials of the user.
+func (s *UsersResourceOp) ListEmbed(ctx context.Context, id string) ([]CredentialsEmbed, *Response, error) {
+	return doList(ctx, s.client, userBasePath, nil, new([]CredentialsEmbed), id, "credentials_embed")
+}
+
+// GetEmbed gets embed credentials of the user.
+func (s *UsersResourceOp) GetEmbed(ctx context.Context, id string, credentialsId string) (*CredentialsEmbed, *Response, error) {
+	return doGet(ctx, s.client, userBasePath, new(CredentialsEmbed), id, "credentials_embed", credentialsId)
+}
+
+// DeleteEmbed deletes embed credentials of the user.
+func (s *UsersResourceOp) DeleteEmbed(ctx context.Context, id string, credentialsId string) (*Response, error) {
+	return doDelete(ctx, s.client, userBasePath, id, "credentials_embed", credentialsId)
+}
+
+// GetGoogle gets the Google credentials of the user.
+func (s *UsersResourceOp) GetGoogle(ctx context.Context, id string) (*CredentialsGoogle, *Response, error) {
+	return doGet(ctx, s.client, userBasePath, new(CredentialsGoogle), id, "credentials_google")
+}
+
+// DeleteGoogle unlinks the Google account of the user.
+func (s *UsersResourceOp) DeleteGoogle(ctx context.Context, id string) (*Response, error) {
+	return doDelete(ctx, s.client, userBasePath, id, "credentials_google")
+}
+
+// GetLDAP gets the LDAP credentials of the user.
+func (s *UsersResourceOp) GetLDAP(ctx context.Context, id string) (*CredentialsLDAP, *Response, error) {
+	return doGet(ctx, s.client, userBasePath, new(CredentialsLDAP), id, "credentials_ldap")
+}
+
+// DeleteLDAP unlinks the LDAP record of the user.
+func (s *UsersResourceOp) DeleteLDAP(ctx context.Context, id string) (*Response, error) {
+	return doDelete(ctx, s.client, userBasePath, id, "credentials_ldap")
+}
+
+// GetLookerOpenid gets the Looker OpenID credentials of the user.
+func (s *UsersResourceOp) GetLookerOpenid(ctx context.Context, id string) (*CredentialsLookerOpenid, *Response, error) {
+	return doGet(ctx, s.client, userBasePath, new(CredentialsLookerOpenid), id, "credentials_looker_openid")
+}
+
+// DeleteLookerOpenid unlinks the Looker OpenID account of the user.
+func (s *UsersResourceOp) DeleteLookerOpenid(ctx context.Context, id string) (*Response, error) {
+	return doDelete(ctx, s.client, userBasePath, id, "credentials_looker_openid")
+}
+
+// GetOIDC gets the OpenID Connect credentials of the user.
+func (s *UsersResourceOp) GetOIDC(ctx context.Context, id string) (*CredentialsOIDC, *Response, error) {
+	return doGet(ctx, s.client, userBasePath, new(CredentialsOIDC), id, "credentials_oidc")
+}
+
+// DeleteOIDC unlinks the OpenID Connect identity of the user.
+func (s *UsersResourceOp) DeleteOIDC(ctx context.Context, id string) (*Response, error) {
+	return doDelete(ctx, s.client, userBasePath, id, "credentials_oidc")
+}
+
+// GetSaml gets the SAML credentials of the user.
+func (s *UsersResourceOp) GetSaml(ctx context.Context, id string) (*CredentialsSaml, *Response, error) {
+	return doGet(ctx, s.client, userBasePath, new(CredentialsSaml), id, "credentials_saml")
+}
+
+// DeleteSaml unlinks the SAML identity of the user.
+func (s *UsersResourceOp) DeleteSaml(ctx context.Context, id string) (*Response, error) {
+	return doDelete(ctx, s.client, userBasePath, id, "credentials_saml")
+}
+
+// CreateTotp enables two-factor authentication for the user, who sets it up on the next login.
+func (s *UsersResourceOp) CreateTotp(ctx context.Context, id string) (*CredentialsTotp, *Response, error) {
+	return doEmptyPost(ctx, s.client, userBasePath, new(CredentialsTotp), id, "credentials_totp")
+}
+
+// GetTotp gets the two-factor authentication credentials of the user.
+func (s *UsersResourceOp) GetTotp(ctx context.Context, id string) (*CredentialsTotp, *Response, error) {
+	return doGet(ctx, s.client, userBasePath, new(CredentialsTotp), id, "credentials_totp")
+}
+
+// DeleteTotp resets the two-factor authentication of the user.
+func (s *UsersResourceOp) DeleteTotp(ctx context.Context, id string) (*Response, error) {
+	return doDelete(ctx, s.client, userBasePath, id, "credentials_totp")
+}
+
 // CreatePasswordReset -
 func (s *UsersResourceOp) CreatePasswordReset(ctx context.Context, id string) (*CredentialsEmail, *Response, error) {
 	return doEmptyPost(ctx, s.client, userBasePath, new(CredentialsEmail),
@@ -273,7 +484,14 @@ func (s *UsersResourceOp) GetRoles(ctx context.Context, id string) ([]Role, *Res
 	return doList(ctx, s.client, userBasePath, nil, new([]Role), id, "roles")
 }
 
-// SetRoles -
+// GetDirectRoles gets the roles assigned to the user, excluding the roles the user only gets through a group.
+func (s *UsersResourceOp) GetDirectRoles(ctx context.Context, id string) ([]Role, *Response, error) {
+	qs := url.Values{}
+	qs.Add("direct_association_only", "true")
+	return doListByX(ctx, s.client, fmt.Sprintf("%s/%s/roles", userBasePath, id), nil, new([]Role), qs)
+}
+
+// SetRoles replaces the roles assigned to the user. An empty roleIds removes all roles.
 func (s *UsersResourceOp) SetRoles(ctx context.Context, id string, roleIds []string) ([]Role, *Response, error) {
 	return doSet(ctx, s.client, userBasePath, roleIds, new([]Role), id, "roles")
 }
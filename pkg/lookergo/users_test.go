@@ -0,0 +1,259 @@
+package lookergo
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestUsersResourceOp_GetDirectRoles(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/4.0/users/60/roles", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testFormValues(t, r, values{"direct_association_only": "true", "limit": "500"})
+		fmt.Fprint(w, `[{"id":"2","name":"Admin"},{"id":"9","name":"Sales viewer"}]`)
+	})
+
+	roles, _, err := client.Users.GetDirectRoles(ctx, "60")
+	if err != nil {
+		t.Fatalf("Users.GetDirectRoles returned error: %v", err)
+	}
+
+	expected := []Role{{Id: 2, Name: "Admin"}, {Id: 9, Name: "Sales viewer"}}
+	if !reflect.DeepEqual(roles, expected) {
+		t.Error(errGotWant("Users.GetDirectRoles", roles, expected))
+	}
+}
+
+func TestUsersResourceOp_SetRoles(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var body string
+	mux.HandleFunc("/4.0/users/60/roles", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		raw, _ := io.ReadAll(r.Body)
+		body = strings.TrimSpace(string(raw))
+		fmt.Fprint(w, `[]`)
+	})
+
+	for _, tc := range []struct {
+		roleIds  []string
+		expected string
+	}{
+		{[]string{"2", "9"}, `["2","9"]`},
+		{[]string{}, `[]`},
+		{nil, `[]`},
+	} {
+		if _, _, err := client.Users.SetRoles(ctx, "60", tc.roleIds); err != nil {
+			t.Fatalf("Users.SetRoles returned error: %v", err)
+		}
+		if body != tc.expected {
+			t.Errorf("Users.SetRoles(%v) request body = %s, expected %s", tc.roleIds, body, tc.expected)
+		}
+	}
+}
+
+func TestRolesResourceOp_RoleDirectUsersList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/4.0/roles/9/users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testFormValues(t, r, values{"direct_association_only": "true", "limit": "500"})
+		fmt.Fprint(w, `[{"id":"60","first_name":"Jane"}]`)
+	})
+
+	users, _, err := client.Roles.RoleDirectUsersList(ctx, 9, nil)
+	if err != nil {
+		t.Fatalf("Roles.RoleDirectUsersList returned error: %v", err)
+	}
+
+	expected := []User{{Id: "60", FirstName: "Jane"}}
+	if !reflect.DeepEqual(users, expected) {
+		t.Error(errGotWant("Roles.RoleDirectUsersList", users, expected))
+	}
+}
+
+func TestUsersResourceOp_Api3(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/4.0/users/60/credentials_api3", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			fmt.Fprint(w, `{"id":"7","client_id":"abc","client_secret":"s3cr3t"}`)
+		default:
+			testMethod(t, r, http.MethodGet)
+			fmt.Fprint(w, `[{"id":"7","client_id":"abc"}]`)
+		}
+	})
+	deleted := false
+	mux.HandleFunc("/4.0/users/60/credentials_api3/7", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodDelete:
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			testMethod(t, r, http.MethodGet)
+			fmt.Fprint(w, `{"id":"7","client_id":"abc"}`)
+		}
+	})
+
+	created, _, err := client.Users.CreateApi3(ctx, "60")
+	if err != nil {
+		t.Fatalf("Users.CreateApi3 returned error: %v", err)
+	}
+	if expected := (&CredentialsApi3{Id: "7", ClientId: "abc", ClientSecret: "s3cr3t"}); !reflect.DeepEqual(created, expected) {
+		t.Error(errGotWant("Users.CreateApi3", created, expected))
+	}
+
+	list, _, err := client.Users.ListApi3(ctx, "60")
+	if err != nil {
+		t.Fatalf("Users.ListApi3 returned error: %v", err)
+	}
+	if expected := []CredentialsApi3{{Id: "7", ClientId: "abc"}}; !reflect.DeepEqual(list, expected) {
+		t.Error(errGotWant("Users.ListApi3", list, expected))
+	}
+
+	got, _, err := client.Users.GetApi3(ctx, "60", "7")
+	if err != nil {
+		t.Fatalf("Users.GetApi3 returned error: %v", err)
+	}
+	if expected := (&CredentialsApi3{Id: "7", ClientId: "abc"}); !reflect.DeepEqual(got, expected) {
+		t.Error(errGotWant("Users.GetApi3", got, expected))
+	}
+
+	if _, err := client.Users.DeleteApi3(ctx, "60", "7"); err != nil {
+		t.Fatalf("Users.DeleteApi3 returned error: %v", err)
+	}
+	if !deleted {
+		t.Error("Users.DeleteApi3 did not delete the credentials")
+	}
+}
+
+func TestUsersResourceOp_GetLDAP(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/4.0/users/60/credentials_ldap", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"email":"jane@example.com","ldap_id":"jane","ldap_dn":"uid=jane,ou=users,dc=example,dc=com","type":"ldap"}`)
+	})
+
+	credentials, _, err := client.Users.GetLDAP(ctx, "60")
+	if err != nil {
+		t.Fatalf("Users.GetLDAP returned error: %v", err)
+	}
+
+	expected := &CredentialsLDAP{Email: "jane@example.com", LdapId: "jane", LdapDn: "uid=jane,ou=users,dc=example,dc=com", Type: "ldap"}
+	if !reflect.DeepEqual(credentials, expected) {
+		t.Error(errGotWant("Users.GetLDAP", credentials, expected))
+	}
+}
+
+func TestUsersResourceOp_DeleteCredentials(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var deleted []string
+	mux.HandleFunc("/4.0/users/60/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		deleted = append(deleted, strings.TrimPrefix(r.URL.Path, "/4.0/users/60/"))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	for _, del := range []func() (*Response, error){
+		func() (*Response, error) { return client.Users.DeleteGoogle(ctx, "60") },
+		func() (*Response, error) { return client.Users.DeleteLDAP(ctx, "60") },
+		func() (*Response, error) { return client.Users.DeleteLookerOpenid(ctx, "60") },
+		func() (*Response, error) { return client.Users.DeleteOIDC(ctx, "60") },
+		func() (*Response, error) { return client.Users.DeleteSaml(ctx, "60") },
+		func() (*Response, error) { return client.Users.DeleteTotp(ctx, "60") },
+		func() (*Response, error) { return client.Users.DeleteEmbed(ctx, "60", "3") },
+	} {
+		if _, err := del(); err != nil {
+			t.Fatalf("delete returned error: %v", err)
+		}
+	}
+
+	expected := []string{"credentials_google", "credentials_ldap", "credentials_looker_openid", "credentials_oidc", "credentials_saml", "credentials_totp", "credentials_embed/3"}
+	if !reflect.DeepEqual(deleted, expected) {
+		t.Error(errGotWant("deleted credentials", deleted, expected))
+	}
+}
+
+func TestUsersResourceOp_Search(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/4.0/users/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testFormValues(t, r, values{"email": "%@example.com", "is_disabled": "false", "sorts": "id", "limit": "500"})
+		fmt.Fprint(w, `[{"id":"1","email":"jane@example.com"},{"id":"2","email":"john@example.com"}]`)
+	})
+
+	users, _, err := client.Users.Search(ctx, &UserSearch{Email: "%@example.com", IsDisabled: Bool(false), Sorts: "id"}, nil)
+	if err != nil {
+		t.Fatalf("Users.Search returned error: %v", err)
+	}
+
+	expected := []User{{Id: "1", Email: "jane@example.com"}, {Id: "2", Email: "john@example.com"}}
+	if !reflect.DeepEqual(users, expected) {
+		t.Error(errGotWant("Users.Search", users, expected))
+	}
+}
+
+func TestGroupsResourceOp_Search(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/4.0/groups/search/with_hierarchy", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testFormValues(t, r, values{"name": "Sales%", "limit": "500"})
+		fmt.Fprint(w, `[{"id":"3","name":"Sales EMEA","parent_group_ids":["1"]}]`)
+	})
+
+	groups, _, err := client.Groups.Search(ctx, &GroupSearch{Name: "Sales%"}, nil)
+	if err != nil {
+		t.Fatalf("Groups.Search returned error: %v", err)
+	}
+
+	expected := []Group{{Id: 3, Name: "Sales EMEA", ParentGroupIds: SliceStringInts{1}}}
+	if !reflect.DeepEqual(groups, expected) {
+		t.Error(errGotWant("Groups.Search", groups, expected))
+	}
+}
+
+func TestUsersResourceOp_SearchPages(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/4.0/users/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if r.URL.Query().Get("offset") == "" {
+			next := *r.URL
+			next.RawQuery = r.URL.RawQuery + "&offset=500"
+			w.Header().Set("Link", fmt.Sprintf(`<%s>; rel="next"`, next.String()))
+			fmt.Fprint(w, `[{"id":"1"}]`)
+			return
+		}
+		testFormValues(t, r, values{"email": "%@example.com", "limit": "500", "offset": "500"})
+		fmt.Fprint(w, `[{"id":"2"}]`)
+	})
+
+	users, _, err := client.Users.Search(ctx, &UserSearch{Email: "%@example.com"}, nil)
+	if err != nil {
+		t.Fatalf("Users.Search returned error: %v", err)
+	}
+
+	expected := []User{{Id: "1"}, {Id: "2"}}
+	if !reflect.DeepEqual(users, expected) {
+		t.Error(errGotWant("Users.Search", users, expected))
+	}
+}